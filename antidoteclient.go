@@ -1,11 +1,18 @@
 package antidoteclient
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"math/rand"
 	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"gopkg.in/fatih/pool.v2"
 )
 
@@ -15,7 +22,49 @@ const MAX_POOL_SIZE = 50
 // Represents connections to the Antidote database.
 // Allows to start/create transaction.
 type Client struct {
-	pools []pool.Pool
+	pools     []*hostPool
+	metrics   Metrics
+	tracer    Tracer
+	logger    *slog.Logger
+	redaction Redaction
+	recorder  *Recorder
+	// maximum lifetime of interactive transactions, 0 if unlimited
+	maxTxLifetime time.Duration
+
+	mutex   sync.Mutex
+	queues  []*UpdateQueue
+	batches []*WriteBatch
+	caches  []*ReadCache
+	// set when the client is closed, no new connections are handed out afterwards
+	closing bool
+	// connections taken from the pools and not yet returned, or being taken
+	inFlight    int
+	connections map[*connection]struct{}
+	// closed when the last in-flight connection is returned during Shutdown
+	idle chan struct{}
+	// interactive transactions neither committed nor aborted
+	transactions map[*openTransaction]struct{}
+	// number of transactions aborted after the maximum lifetime or garbage collected while open
+	expiredTransactions uint64
+	leakedTransactions  uint64
+}
+
+// Optional settings of a Client.
+type ClientOptions struct {
+	// Receives measurements of operations, connection pools and traffic; nil disables metrics
+	Metrics Metrics
+	// Records interactive transactions as spans; nil disables tracing
+	Tracer Tracer
+	// Receives log messages of connection pools, transactions and, at debug level, of every frame sent and received;
+	// nil disables logging
+	Logger *slog.Logger
+	// Hides keys and values in logged frames
+	Redaction Redaction
+	// Interactive transactions open longer than this are aborted by the client and their connection is returned to the pool;
+	// 0 allows transactions to stay open until committed or aborted
+	MaxTransactionLifetime time.Duration
+	// Records every frame sent and received, e.g. to reproduce bugs; nil disables recording
+	Recorder *Recorder
 }
 
 // Represents an Antidote server.
@@ -25,60 +74,326 @@ type Host struct {
 	Port int
 }
 
+// Returns the address of the host, with IPv6 addresses in brackets
+func (h Host) String() string {
+	return net.JoinHostPort(h.Name, strconv.Itoa(h.Port))
+}
+
+// Parses a host of the form name:port, the inverse of Host.String
+func ParseHost(s string) (Host, error) {
+	name, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return Host{}, err
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return Host{}, fmt.Errorf("invalid port %q", port)
+	}
+	return Host{Name: name, Port: p}, nil
+}
+
+// The connection pool of a single host
+type hostPool struct {
+	host Host
+	pool pool.Pool
+	// number of connections taken from the pool and not yet returned
+	inUse int64
+}
+
 // Recreates a new Antidote client connected to the given Antidote servers.
 // Remember to close the client to clean-up the connections in the connection pool
 func NewClient(hosts ...Host) (client *Client, err error) {
-	pools := make([]pool.Pool, len(hosts))
+	return NewClientWithOptions(ClientOptions{}, hosts...)
+}
+
+// Creates a new Antidote client connected to the given Antidote servers using the given options.
+// Remember to close the client to clean-up the connections in the connection pool
+func NewClientWithOptions(options ClientOptions, hosts ...Host) (client *Client, err error) {
+	pools := make([]*hostPool, len(hosts))
+	// numbers the connections of all pools, or of all clients sharing the recorder
+	var connections uint64
+	nextID := func() uint64 { return atomic.AddUint64(&connections, 1) }
+	if options.Recorder != nil {
+		nextID = options.Recorder.NewConnection
+	}
 	for i, h := range hosts {
-		p, err := pool.NewChannelPool(INITIAL_POOL_SIZE, MAX_POOL_SIZE, func() (net.Conn, error) { return net.Dial("tcp", fmt.Sprintf("%s:%d", h.Name, h.Port)) })
+		addr := h.String()
+		p, err := pool.NewChannelPool(INITIAL_POOL_SIZE, MAX_POOL_SIZE, func() (net.Conn, error) {
+			con, err := net.Dial("tcp", addr)
+			if err != nil {
+				return nil, err
+			}
+			return &dialedConn{Conn: con, id: nextID()}, nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		pools[i] = p
+		pools[i] = &hostPool{host: h, pool: p}
 	}
 	client = &Client{
-		pools: pools,
+		pools:     pools,
+		metrics:   options.Metrics,
+		tracer:    options.Tracer,
+		logger:    options.Logger,
+		redaction: options.Redaction,
+		recorder:  options.Recorder,
+
+		maxTxLifetime: options.MaxTransactionLifetime,
+		connections:   make(map[*connection]struct{}),
+		transactions:  make(map[*openTransaction]struct{}),
 	}
 	return
 }
 
 // Call close after using the client to clean up the connections int he connection pool and release resources.
+// Waits until the updates buffered in update queues and write batches of this client are sent;
+// failures to send them are only returned by Shutdown.
+// Does not wait for in-flight operations and open transactions, see Shutdown.
 func (client *Client) Close() {
+	client.mutex.Lock()
+	queues, batches := client.queues, client.batches
+	client.queues, client.batches = nil, nil
+	client.mutex.Unlock()
+	for _, q := range queues {
+		q.Close()
+	}
+	for _, b := range batches {
+		b.Close()
+	}
+	client.mutex.Lock()
+	client.closing = true
+	client.mutex.Unlock()
 	for _, p := range client.pools {
-		p.Close()
+		p.pool.Close()
+	}
+}
+
+func (client *Client) addQueue(queue *UpdateQueue) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.queues = append(client.queues, queue)
+}
+
+func (client *Client) removeQueue(queue *UpdateQueue) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	for i, q := range client.queues {
+		if q == queue {
+			client.queues = append(client.queues[:i], client.queues[i+1:]...)
+			return
+		}
+	}
+}
+
+func (client *Client) addBatch(batch *WriteBatch) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.batches = append(client.batches, batch)
+}
+
+func (client *Client) removeBatch(batch *WriteBatch) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	for i, b := range client.batches {
+		if b == batch {
+			client.batches = append(client.batches[:i], client.batches[i+1:]...)
+			return
+		}
+	}
+}
+
+func (client *Client) addCache(cache *ReadCache) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.caches = append(client.caches, cache)
+}
+
+func (client *Client) removeCache(cache *ReadCache) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	// copy, committed iterates over the old slice without holding the lock
+	caches := make([]*ReadCache, 0, len(client.caches))
+	for _, c := range client.caches {
+		if c != cache {
+			caches = append(caches, c)
+		}
+	}
+	client.caches = caches
+}
+
+// Called after updates to the given objects were committed by this client; invalidates cached reads.
+func (client *Client) committed(objects []*ApbBoundObject) {
+	if len(objects) == 0 {
+		return
+	}
+	client.mutex.Lock()
+	caches := client.caches
+	client.mutex.Unlock()
+	for _, c := range caches {
+		c.Invalidate(objects...)
 	}
 }
 
 func (client *Client) getConnection() (c *connection, err error) {
+	err = client.beginOperation()
+	if err != nil {
+		return
+	}
 	// maybe make this global?
+	if len(client.pools) == 0 {
+		client.endOperation(nil)
+		err = fmt.Errorf("All connections dead")
+		return
+	}
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	for _, i := range r.Perm(len(client.pools)) {
-		p := client.pools[i]
-		con, err := p.Get()
-		if err != nil {
-			return nil, err
-		}
-		c = &connection{
-			Conn: con,
-			pool: p,
+	return client.takeConnection(client.pools[r.Intn(len(client.pools))])
+}
+
+// Returns the pool of the given host; fails if the host was not given when creating the client.
+func (client *Client) hostPool(host Host) (*hostPool, error) {
+	for _, p := range client.pools {
+		if p.host == host {
+			return p, nil
 		}
-		return c, nil
 	}
-	err = fmt.Errorf("All connections dead")
-	return
+	return nil, fmt.Errorf("%w: %s", ErrUnknownHost, host)
+}
+
+// Takes a connection from the pool of a specific host, or of a random host if p is nil.
+func (client *Client) connectTo(p *hostPool) (c *connection, err error) {
+	if p == nil {
+		return client.getConnection()
+	}
+	err = client.beginOperation()
+	if err != nil {
+		return
+	}
+	return client.takeConnection(p)
+}
+
+// Takes a connection from the pool for an operation registered with beginOperation.
+func (client *Client) takeConnection(p *hostPool) (c *connection, err error) {
+	con, err := p.pool.Get()
+	if err != nil {
+		client.endOperation(nil)
+		client.warn("could not get connection", slog.String("host", p.host.String()), slog.Any("error", err))
+		return nil, err
+	}
+	c = &connection{
+		Conn:   con,
+		id:     connectionID(con),
+		pool:   p.pool,
+		host:   p,
+		client: client,
+	}
+	client.mutex.Lock()
+	client.connections[c] = struct{}{}
+	client.mutex.Unlock()
+	client.reportPoolUsage(p, atomic.AddInt64(&p.inUse, 1))
+	return c, nil
+}
+
+// Registers an operation about to take a connection from a pool.
+// Fails if the client is closed.
+func (client *Client) beginOperation() error {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	if client.closing {
+		return ErrClientClosed
+	}
+	client.inFlight++
+	return nil
+}
+
+// Unregisters an operation when its connection is returned, c is nil if no connection was taken.
+func (client *Client) endOperation(c *connection) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	delete(client.connections, c)
+	client.inFlight--
+	if client.inFlight == 0 && client.idle != nil {
+		close(client.idle)
+		client.idle = nil
+	}
+}
+
+func (client *Client) reportPoolUsage(p *hostPool, inUse int64) {
+	if client.metrics != nil {
+		client.metrics.PoolUsage(p.host, int(inUse), p.pool.Len())
+	}
 }
 
 // a close already puts the connection back into the right pool
 type connection struct {
 	net.Conn
-	pool pool.Pool
+	// number of the network connection, see RecordedFrame
+	id     uint64
+	pool   pool.Pool
+	host   *hostPool
+	client *Client
+	// set when the connection is returned, makes Close idempotent
+	closed int32
+}
+
+func (c *connection) Read(b []byte) (n int, err error) {
+	n, err = c.Conn.Read(b)
+	if c.client.metrics != nil && n > 0 {
+		c.client.metrics.BytesReceived(c.host.host, n)
+	}
+	return
+}
+
+func (c *connection) Write(b []byte) (n int, err error) {
+	n, err = c.Conn.Write(b)
+	if c.client.metrics != nil && n > 0 {
+		c.client.metrics.BytesSent(c.host.host, n)
+	}
+	return
+}
+
+func (c *connection) Close() error {
+	if !atomic.CompareAndSwapInt32(&c.closed, 0, 1) {
+		return nil
+	}
+	err := c.Conn.Close()
+	c.client.reportPoolUsage(c.host, atomic.AddInt64(&c.host.inUse, -1))
+	c.client.endOperation(c)
+	return err
+}
+
+// Closes the network connection instead of returning it to the pool.
+// Used after errors that leave the connection in an unknown state.
+func (c *connection) discard() error {
+	if pc, ok := c.Conn.(*pool.PoolConn); ok {
+		pc.MarkUnusable()
+	}
+	return c.Close()
 }
 
 // Starts an interactive transaction and registers it on the Antidote server.
 // The connection used to issue reads and updates is sticky;
 // interactive transactions are only valid local to the server they are started on.
 func (client *Client) StartTransaction() (tx *InteractiveTransaction, err error) {
-	con, err := client.getConnection()
+	return client.StartTransactionContext(context.Background())
+}
+
+// Starts an interactive transaction like StartTransaction.
+// The context is passed to the tracer of the client, e.g. to record the transaction as part of a distributed trace.
+func (client *Client) StartTransactionContext(ctx context.Context) (tx *InteractiveTransaction, err error) {
+	return client.startTransaction(ctx, nil)
+}
+
+// Starts an interactive transaction on the host of the pool, or a random host if nil.
+func (client *Client) startTransaction(ctx context.Context, p *hostPool) (tx *InteractiveTransaction, err error) {
+	var con *connection
+	defer func(start time.Time) { client.observe(OpStartTransaction, con, start, err) }(time.Now())
+	span := client.startTransactionSpan(ctx)
+	defer func() {
+		if err != nil {
+			span.End(OpStartTransaction, nil, err)
+		}
+	}()
+	con, err = client.connectTo(p)
 	if err != nil {
 		return
 	}
@@ -87,20 +402,21 @@ func (client *Client) StartTransaction() (tx *InteractiveTransaction, err error)
 	apbtxn := &ApbStartTransaction{
 		Properties: &ApbTxnProperties{ReadWrite: &readwrite, RedBlue: &blue},
 	}
-	err = apbtxn.encode(con)
+	apbtxnresp := &ApbStartTransactionResp{}
+	err = roundTrip(con, apbtxn, apbtxnresp)
 	if err != nil {
+		con.discard()
 		return
 	}
-
-	apbtxnresp, err := decodeStartTransactionResp(con)
-	if err != nil {
+	if !apbtxnresp.GetSuccess() {
+		con.Close()
+		err = &ServerError{Code: apbtxnresp.GetErrorcode()}
 		return
 	}
 	txndesc := apbtxnresp.TransactionDescriptor
-	tx = &InteractiveTransaction{
-		con:  con,
-		txID: txndesc,
-	}
+	tx = newInteractiveTransaction(client, con, txndesc, span)
+	span.Started(con.host.host, txndesc)
+	client.debug("transaction started", slog.String("host", con.host.host.String()), slog.String("tx", hex.EncodeToString(txndesc)))
 	return
 }
 
@@ -109,80 +425,112 @@ func (client *Client) CreateStaticTransaction() *StaticTransaction {
 	return &StaticTransaction{client: client}
 }
 
-// Creates a data center with the given node names
+// Creates a data center with the given node names on a random host of the client
 func (client *Client) CreateDc(nodeNames []string) (err error) {
-	con, err := client.getConnection()
-	if err != nil {
-		return
-	}
-	createDc := &ApbCreateDC{
-		Nodes: nodeNames,
-	}
+	return client.createDc(context.Background(), nil, nodeNames)
+}
 
-	err = createDc.encode(con)
+// Creates a data center with the given node names on the given host of the client
+func (client *Client) CreateDcAt(host Host, nodeNames []string) (err error) {
+	p, err := client.hostPool(host)
 	if err != nil {
-		return
+		return &ClusterError{Op: OpCreateDC, Host: host, Err: err}
 	}
+	return client.createDc(context.Background(), p, nodeNames)
+}
 
-	resp, err := decodeApbCreateDCResp(con)
-	if err != nil {
-		return
-	}
-	if !*resp.Success {
-		return fmt.Errorf("Could not create DC, error code %v", *resp.Errorcode)
+func (client *Client) createDc(ctx context.Context, p *hostPool, nodeNames []string) error {
+	createDc := &ApbCreateDC{
+		Nodes: nodeNames,
 	}
-	return
+	return client.clusterRoundTrip(ctx, OpCreateDC, p, createDc, &ApbCreateDCResp{})
 }
 
-// Get a connection descriptor for the data center
+// Get a connection descriptor for the data center from a random host of the client
 // The descriptor can then be used with ConnectToDCs
 func (client *Client) GetConnectionDescriptor() (descriptor []byte, err error) {
-	con, err := client.getConnection()
-	if err != nil {
-		return
-	}
-	getCD := &ApbGetConnectionDescriptor{
-	}
+	return client.getConnectionDescriptor(context.Background(), nil)
+}
 
-	err = getCD.encode(con)
+// Get a connection descriptor for the data center from the given host of the client
+func (client *Client) GetConnectionDescriptorAt(host Host) (descriptor []byte, err error) {
+	p, err := client.hostPool(host)
 	if err != nil {
-		return
+		return nil, &ClusterError{Op: OpGetConnectionDescriptor, Host: host, Err: err}
 	}
+	return client.getConnectionDescriptor(context.Background(), p)
+}
 
-	resp, err := decodeApbGetConnectionDescriptorResp(con)
+func (client *Client) getConnectionDescriptor(ctx context.Context, p *hostPool) (descriptor []byte, err error) {
+	resp := &ApbGetConnectionDescriptorResp{}
+	err = client.clusterRoundTrip(ctx, OpGetConnectionDescriptor, p, &ApbGetConnectionDescriptor{}, resp)
 	if err != nil {
 		return
 	}
-	if !*resp.Success {
-		err = fmt.Errorf("Could not create DC, error code %v", *resp.Errorcode)
-		return
-	}
-	descriptor = resp.Descriptor_
-	return
+	return resp.GetDescriptor_(), nil
 }
 
-
+// Connects the data center of a random host of the client to the data centers with the given descriptors.
+// Must be called for every data center with the descriptors of all data centers.
 func (client *Client) ConnectToDCs(descriptors [][]byte) (err error) {
-	con, err := client.getConnection()
+	return client.connectToDCs(context.Background(), nil, descriptors)
+}
+
+// Connects the data center of the given host of the client to the data centers with the given descriptors.
+func (client *Client) ConnectToDCsAt(host Host, descriptors [][]byte) (err error) {
+	p, err := client.hostPool(host)
 	if err != nil {
-		return
+		return &ClusterError{Op: OpConnectToDCs, Host: host, Err: err}
 	}
-	getCD := &ApbConnectToDCs{
+	return client.connectToDCs(context.Background(), p, descriptors)
+}
+
+func (client *Client) connectToDCs(ctx context.Context, p *hostPool, descriptors [][]byte) error {
+	connect := &ApbConnectToDCs{
 		Descriptors: descriptors,
 	}
+	return client.clusterRoundTrip(ctx, OpConnectToDCs, p, connect, &ApbConnectToDCsResp{})
+}
+
+// Responses of cluster management operations
+type clusterResp interface {
+	proto.Message
+	GetSuccess() bool
+	GetErrorcode() uint32
+}
 
-	err = getCD.encode(con)
+// Sends a cluster management request to the host of the pool, or a random host if nil.
+// When the context is done, the request is interrupted and fails with the error of the context.
+// Errors are returned as *ClusterError.
+func (client *Client) clusterRoundTrip(ctx context.Context, op Operation, p *hostPool, req proto.Message, resp clusterResp) (err error) {
+	var con *connection
+	defer func(start time.Time) { client.observe(op, con, start, err) }(time.Now())
+	var host Host
+	defer func() {
+		if err != nil {
+			err = &ClusterError{Op: op, Host: host, Err: err}
+		}
+	}()
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	con, err = client.connectTo(p)
 	if err != nil {
 		return
 	}
-
-	resp, err := decodeApbConnectToDCsResp(con)
+	host = con.host.host
+	// creating a data center takes long, unblock the round trip when the context is done
+	err = roundTripContext(ctx, con, req, resp)
 	if err != nil {
+		con.discard()
 		return
 	}
-	if !*resp.Success {
-		err = fmt.Errorf("Could not create DC, error code %v", *resp.Errorcode)
+	err = con.Close()
+	if err != nil {
 		return
 	}
-	return
-}
\ No newline at end of file
+	if !resp.GetSuccess() {
+		return &ServerError{Code: resp.GetErrorcode()}
+	}
+	return nil
+}
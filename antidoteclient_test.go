@@ -432,3 +432,69 @@ func TestMapListMapKeys(t *testing.T) {
 		}
 	}
 }
+
+func TestObjectRefs(t *testing.T) {
+	client, err := NewClient(Host{"127.0.0.1", 8087})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	timestamp := time.Now().Unix()
+	bucketname := fmt.Sprintf("bucket%d", timestamp)
+	bucket := Bucket{[]byte(bucketname)}
+
+	counter := bucket.Counter(Key("refCounter"))
+	set := bucket.Set(Key("refSet"))
+	reg := bucket.Reg(Key("refReg"))
+	mp := bucket.Map(Key("refMap"))
+	flag := bucket.Flag(Key("refFlag"))
+
+	if err = counter.Inc(tx, 7); err != nil {
+		t.Fatal(err)
+	}
+	if err = set.Add(tx, []byte("A"), []byte("B")); err != nil {
+		t.Fatal(err)
+	}
+	if err = set.Remove(tx, []byte("A")); err != nil {
+		t.Fatal(err)
+	}
+	if err = reg.Put(tx, []byte("Hello World")); err != nil {
+		t.Fatal(err)
+	}
+	if err = mp.Update(tx, CounterInc(Key("counter"), 3)); err != nil {
+		t.Fatal(err)
+	}
+	if err = flag.Put(tx, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, e := counter.Get(tx); e != nil || v != 7 {
+		t.Fatalf("Wrong counter value: %d (%v)", v, e)
+	}
+	if v, e := set.Get(tx); e != nil || len(v) != 1 || string(v[0]) != "B" {
+		t.Fatalf("Wrong set value: %s (%v)", v, e)
+	}
+	if v, e := reg.Get(tx); e != nil || !bytes.Equal(v, []byte("Hello World")) {
+		t.Fatalf("Wrong reg value: %s (%v)", v, e)
+	}
+	mapVal, err := mp.Get(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, e := mapVal.Counter(Key("counter")); e != nil || v != 3 {
+		t.Fatalf("Wrong nested counter value: %d (%v)", v, e)
+	}
+	if v, e := flag.Get(tx); e != nil || !v {
+		t.Fatalf("Wrong flag value: %t (%v)", v, e)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
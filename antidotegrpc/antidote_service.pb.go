@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: antidotegrpc/antidote_service.proto
+
+package antidotegrpc
+
+import (
+	antidote_go_client "github.com/AntidoteDB/antidote-go-client"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReadRequest struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Objects       []*antidote_go_client.ApbBoundObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRequest) ProtoMessage() {}
+
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReadRequest) GetObjects() []*antidote_go_client.ApbBoundObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type ReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Values of the objects, in the order of the request
+	Objects       []*antidote_go_client.ApbReadObjectResp `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadResponse) Reset() {
+	*x = ReadResponse{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadResponse) ProtoMessage() {}
+
+func (x *ReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
+func (*ReadResponse) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadResponse) GetObjects() []*antidote_go_client.ApbReadObjectResp {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type UpdateRequest struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Updates       []*antidote_go_client.ApbUpdateOp `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateRequest) GetUpdates() []*antidote_go_client.ApbUpdateOp {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type UpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{3}
+}
+
+type CommitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitRequest) Reset() {
+	*x = CommitRequest{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitRequest) ProtoMessage() {}
+
+func (x *CommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitRequest.ProtoReflect.Descriptor instead.
+func (*CommitRequest) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{4}
+}
+
+type CommitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitResponse) Reset() {
+	*x = CommitResponse{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitResponse) ProtoMessage() {}
+
+func (x *CommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitResponse.ProtoReflect.Descriptor instead.
+func (*CommitResponse) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{5}
+}
+
+type AbortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortRequest) Reset() {
+	*x = AbortRequest{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortRequest) ProtoMessage() {}
+
+func (x *AbortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortRequest.ProtoReflect.Descriptor instead.
+func (*AbortRequest) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{6}
+}
+
+type AbortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortResponse) Reset() {
+	*x = AbortResponse{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortResponse) ProtoMessage() {}
+
+func (x *AbortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortResponse.ProtoReflect.Descriptor instead.
+func (*AbortResponse) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{7}
+}
+
+type TransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*TransactionRequest_Read
+	//	*TransactionRequest_Update
+	//	*TransactionRequest_Commit
+	//	*TransactionRequest_Abort
+	Request       isTransactionRequest_Request `protobuf_oneof:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRequest) Reset() {
+	*x = TransactionRequest{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRequest) ProtoMessage() {}
+
+func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TransactionRequest) GetRequest() isTransactionRequest_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetRead() *ReadRequest {
+	if x != nil {
+		if x, ok := x.Request.(*TransactionRequest_Read); ok {
+			return x.Read
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetUpdate() *UpdateRequest {
+	if x != nil {
+		if x, ok := x.Request.(*TransactionRequest_Update); ok {
+			return x.Update
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetCommit() *CommitRequest {
+	if x != nil {
+		if x, ok := x.Request.(*TransactionRequest_Commit); ok {
+			return x.Commit
+		}
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetAbort() *AbortRequest {
+	if x != nil {
+		if x, ok := x.Request.(*TransactionRequest_Abort); ok {
+			return x.Abort
+		}
+	}
+	return nil
+}
+
+type isTransactionRequest_Request interface {
+	isTransactionRequest_Request()
+}
+
+type TransactionRequest_Read struct {
+	Read *ReadRequest `protobuf:"bytes,1,opt,name=read,proto3,oneof"`
+}
+
+type TransactionRequest_Update struct {
+	Update *UpdateRequest `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type TransactionRequest_Commit struct {
+	Commit *CommitRequest `protobuf:"bytes,3,opt,name=commit,proto3,oneof"`
+}
+
+type TransactionRequest_Abort struct {
+	Abort *AbortRequest `protobuf:"bytes,4,opt,name=abort,proto3,oneof"`
+}
+
+func (*TransactionRequest_Read) isTransactionRequest_Request() {}
+
+func (*TransactionRequest_Update) isTransactionRequest_Request() {}
+
+func (*TransactionRequest_Commit) isTransactionRequest_Request() {}
+
+func (*TransactionRequest_Abort) isTransactionRequest_Request() {}
+
+type TransactionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*TransactionResponse_Read
+	//	*TransactionResponse_Update
+	//	*TransactionResponse_Commit
+	//	*TransactionResponse_Abort
+	Response      isTransactionResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionResponse) Reset() {
+	*x = TransactionResponse{}
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResponse) ProtoMessage() {}
+
+func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_antidotegrpc_antidote_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
+	return file_antidotegrpc_antidote_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransactionResponse) GetResponse() isTransactionResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetRead() *ReadResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Read); ok {
+			return x.Read
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetUpdate() *UpdateResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Update); ok {
+			return x.Update
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetCommit() *CommitResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Commit); ok {
+			return x.Commit
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetAbort() *AbortResponse {
+	if x != nil {
+		if x, ok := x.Response.(*TransactionResponse_Abort); ok {
+			return x.Abort
+		}
+	}
+	return nil
+}
+
+type isTransactionResponse_Response interface {
+	isTransactionResponse_Response()
+}
+
+type TransactionResponse_Read struct {
+	Read *ReadResponse `protobuf:"bytes,1,opt,name=read,proto3,oneof"`
+}
+
+type TransactionResponse_Update struct {
+	Update *UpdateResponse `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type TransactionResponse_Commit struct {
+	Commit *CommitResponse `protobuf:"bytes,3,opt,name=commit,proto3,oneof"`
+}
+
+type TransactionResponse_Abort struct {
+	Abort *AbortResponse `protobuf:"bytes,4,opt,name=abort,proto3,oneof"`
+}
+
+func (*TransactionResponse_Read) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_Update) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_Commit) isTransactionResponse_Response() {}
+
+func (*TransactionResponse_Abort) isTransactionResponse_Response() {}
+
+var File_antidotegrpc_antidote_service_proto protoreflect.FileDescriptor
+
+const file_antidotegrpc_antidote_service_proto_rawDesc = "" +
+	"\n" +
+	"#antidotegrpc/antidote_service.proto\x12\rantidote.grpc\x1a\x0eantidote.proto\"8\n" +
+	"\vReadRequest\x12)\n" +
+	"\aobjects\x18\x01 \x03(\v2\x0f.ApbBoundObjectR\aobjects\"<\n" +
+	"\fReadResponse\x12,\n" +
+	"\aobjects\x18\x01 \x03(\v2\x12.ApbReadObjectRespR\aobjects\"7\n" +
+	"\rUpdateRequest\x12&\n" +
+	"\aupdates\x18\x01 \x03(\v2\f.ApbUpdateOpR\aupdates\"\x10\n" +
+	"\x0eUpdateResponse\"\x0f\n" +
+	"\rCommitRequest\"\x10\n" +
+	"\x0eCommitResponse\"\x0e\n" +
+	"\fAbortRequest\"\x0f\n" +
+	"\rAbortResponse\"\xf6\x01\n" +
+	"\x12TransactionRequest\x120\n" +
+	"\x04read\x18\x01 \x01(\v2\x1a.antidote.grpc.ReadRequestH\x00R\x04read\x126\n" +
+	"\x06update\x18\x02 \x01(\v2\x1c.antidote.grpc.UpdateRequestH\x00R\x06update\x126\n" +
+	"\x06commit\x18\x03 \x01(\v2\x1c.antidote.grpc.CommitRequestH\x00R\x06commit\x123\n" +
+	"\x05abort\x18\x04 \x01(\v2\x1b.antidote.grpc.AbortRequestH\x00R\x05abortB\t\n" +
+	"\arequest\"\xfc\x01\n" +
+	"\x13TransactionResponse\x121\n" +
+	"\x04read\x18\x01 \x01(\v2\x1b.antidote.grpc.ReadResponseH\x00R\x04read\x127\n" +
+	"\x06update\x18\x02 \x01(\v2\x1d.antidote.grpc.UpdateResponseH\x00R\x06update\x127\n" +
+	"\x06commit\x18\x03 \x01(\v2\x1d.antidote.grpc.CommitResponseH\x00R\x06commit\x124\n" +
+	"\x05abort\x18\x04 \x01(\v2\x1c.antidote.grpc.AbortResponseH\x00R\x05abortB\n" +
+	"\n" +
+	"\bresponse2\xec\x01\n" +
+	"\bAntidote\x12?\n" +
+	"\x04Read\x12\x1a.antidote.grpc.ReadRequest\x1a\x1b.antidote.grpc.ReadResponse\x12E\n" +
+	"\x06Update\x12\x1c.antidote.grpc.UpdateRequest\x1a\x1d.antidote.grpc.UpdateResponse\x12X\n" +
+	"\vTransaction\x12!.antidote.grpc.TransactionRequest\x1a\".antidote.grpc.TransactionResponse(\x010\x01B7Z5github.com/AntidoteDB/antidote-go-client/antidotegrpcb\x06proto3"
+
+var (
+	file_antidotegrpc_antidote_service_proto_rawDescOnce sync.Once
+	file_antidotegrpc_antidote_service_proto_rawDescData []byte
+)
+
+func file_antidotegrpc_antidote_service_proto_rawDescGZIP() []byte {
+	file_antidotegrpc_antidote_service_proto_rawDescOnce.Do(func() {
+		file_antidotegrpc_antidote_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_antidotegrpc_antidote_service_proto_rawDesc), len(file_antidotegrpc_antidote_service_proto_rawDesc)))
+	})
+	return file_antidotegrpc_antidote_service_proto_rawDescData
+}
+
+var file_antidotegrpc_antidote_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_antidotegrpc_antidote_service_proto_goTypes = []any{
+	(*ReadRequest)(nil),                          // 0: antidote.grpc.ReadRequest
+	(*ReadResponse)(nil),                         // 1: antidote.grpc.ReadResponse
+	(*UpdateRequest)(nil),                        // 2: antidote.grpc.UpdateRequest
+	(*UpdateResponse)(nil),                       // 3: antidote.grpc.UpdateResponse
+	(*CommitRequest)(nil),                        // 4: antidote.grpc.CommitRequest
+	(*CommitResponse)(nil),                       // 5: antidote.grpc.CommitResponse
+	(*AbortRequest)(nil),                         // 6: antidote.grpc.AbortRequest
+	(*AbortResponse)(nil),                        // 7: antidote.grpc.AbortResponse
+	(*TransactionRequest)(nil),                   // 8: antidote.grpc.TransactionRequest
+	(*TransactionResponse)(nil),                  // 9: antidote.grpc.TransactionResponse
+	(*antidote_go_client.ApbBoundObject)(nil),    // 10: ApbBoundObject
+	(*antidote_go_client.ApbReadObjectResp)(nil), // 11: ApbReadObjectResp
+	(*antidote_go_client.ApbUpdateOp)(nil),       // 12: ApbUpdateOp
+}
+var file_antidotegrpc_antidote_service_proto_depIdxs = []int32{
+	10, // 0: antidote.grpc.ReadRequest.objects:type_name -> ApbBoundObject
+	11, // 1: antidote.grpc.ReadResponse.objects:type_name -> ApbReadObjectResp
+	12, // 2: antidote.grpc.UpdateRequest.updates:type_name -> ApbUpdateOp
+	0,  // 3: antidote.grpc.TransactionRequest.read:type_name -> antidote.grpc.ReadRequest
+	2,  // 4: antidote.grpc.TransactionRequest.update:type_name -> antidote.grpc.UpdateRequest
+	4,  // 5: antidote.grpc.TransactionRequest.commit:type_name -> antidote.grpc.CommitRequest
+	6,  // 6: antidote.grpc.TransactionRequest.abort:type_name -> antidote.grpc.AbortRequest
+	1,  // 7: antidote.grpc.TransactionResponse.read:type_name -> antidote.grpc.ReadResponse
+	3,  // 8: antidote.grpc.TransactionResponse.update:type_name -> antidote.grpc.UpdateResponse
+	5,  // 9: antidote.grpc.TransactionResponse.commit:type_name -> antidote.grpc.CommitResponse
+	7,  // 10: antidote.grpc.TransactionResponse.abort:type_name -> antidote.grpc.AbortResponse
+	0,  // 11: antidote.grpc.Antidote.Read:input_type -> antidote.grpc.ReadRequest
+	2,  // 12: antidote.grpc.Antidote.Update:input_type -> antidote.grpc.UpdateRequest
+	8,  // 13: antidote.grpc.Antidote.Transaction:input_type -> antidote.grpc.TransactionRequest
+	1,  // 14: antidote.grpc.Antidote.Read:output_type -> antidote.grpc.ReadResponse
+	3,  // 15: antidote.grpc.Antidote.Update:output_type -> antidote.grpc.UpdateResponse
+	9,  // 16: antidote.grpc.Antidote.Transaction:output_type -> antidote.grpc.TransactionResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_antidotegrpc_antidote_service_proto_init() }
+func file_antidotegrpc_antidote_service_proto_init() {
+	if File_antidotegrpc_antidote_service_proto != nil {
+		return
+	}
+	file_antidotegrpc_antidote_service_proto_msgTypes[8].OneofWrappers = []any{
+		(*TransactionRequest_Read)(nil),
+		(*TransactionRequest_Update)(nil),
+		(*TransactionRequest_Commit)(nil),
+		(*TransactionRequest_Abort)(nil),
+	}
+	file_antidotegrpc_antidote_service_proto_msgTypes[9].OneofWrappers = []any{
+		(*TransactionResponse_Read)(nil),
+		(*TransactionResponse_Update)(nil),
+		(*TransactionResponse_Commit)(nil),
+		(*TransactionResponse_Abort)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_antidotegrpc_antidote_service_proto_rawDesc), len(file_antidotegrpc_antidote_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_antidotegrpc_antidote_service_proto_goTypes,
+		DependencyIndexes: file_antidotegrpc_antidote_service_proto_depIdxs,
+		MessageInfos:      file_antidotegrpc_antidote_service_proto_msgTypes,
+	}.Build()
+	File_antidotegrpc_antidote_service_proto = out.File
+	file_antidotegrpc_antidote_service_proto_goTypes = nil
+	file_antidotegrpc_antidote_service_proto_depIdxs = nil
+}
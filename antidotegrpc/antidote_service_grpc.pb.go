@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: antidotegrpc/antidote_service.proto
+
+package antidotegrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Antidote_Read_FullMethodName        = "/antidote.grpc.Antidote/Read"
+	Antidote_Update_FullMethodName      = "/antidote.grpc.Antidote/Update"
+	Antidote_Transaction_FullMethodName = "/antidote.grpc.Antidote/Transaction"
+)
+
+// AntidoteClient is the client API for Antidote service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Reads and updates Antidote objects.
+// The messages describing objects, updates and values are the ones of the Antidote protocol.
+type AntidoteClient interface {
+	// Reads objects in a static transaction
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	// Updates objects in a static transaction
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Runs an interactive transaction for the lifetime of the stream.
+	// Every request is answered with a response; the stream ends after commit or abort.
+	// The transaction is aborted if the client closes the stream or an operation fails.
+	Transaction(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TransactionRequest, TransactionResponse], error)
+}
+
+type antidoteClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAntidoteClient(cc grpc.ClientConnInterface) AntidoteClient {
+	return &antidoteClient{cc}
+}
+
+func (c *antidoteClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadResponse)
+	err := c.cc.Invoke(ctx, Antidote_Read_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *antidoteClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, Antidote_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *antidoteClient) Transaction(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TransactionRequest, TransactionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Antidote_ServiceDesc.Streams[0], Antidote_Transaction_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TransactionRequest, TransactionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Antidote_TransactionClient = grpc.BidiStreamingClient[TransactionRequest, TransactionResponse]
+
+// AntidoteServer is the server API for Antidote service.
+// All implementations must embed UnimplementedAntidoteServer
+// for forward compatibility.
+//
+// Reads and updates Antidote objects.
+// The messages describing objects, updates and values are the ones of the Antidote protocol.
+type AntidoteServer interface {
+	// Reads objects in a static transaction
+	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	// Updates objects in a static transaction
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Runs an interactive transaction for the lifetime of the stream.
+	// Every request is answered with a response; the stream ends after commit or abort.
+	// The transaction is aborted if the client closes the stream or an operation fails.
+	Transaction(grpc.BidiStreamingServer[TransactionRequest, TransactionResponse]) error
+	mustEmbedUnimplementedAntidoteServer()
+}
+
+// UnimplementedAntidoteServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAntidoteServer struct{}
+
+func (UnimplementedAntidoteServer) Read(context.Context, *ReadRequest) (*ReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedAntidoteServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedAntidoteServer) Transaction(grpc.BidiStreamingServer[TransactionRequest, TransactionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Transaction not implemented")
+}
+func (UnimplementedAntidoteServer) mustEmbedUnimplementedAntidoteServer() {}
+func (UnimplementedAntidoteServer) testEmbeddedByValue()                  {}
+
+// UnsafeAntidoteServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AntidoteServer will
+// result in compilation errors.
+type UnsafeAntidoteServer interface {
+	mustEmbedUnimplementedAntidoteServer()
+}
+
+func RegisterAntidoteServer(s grpc.ServiceRegistrar, srv AntidoteServer) {
+	// If the following call pancis, it indicates UnimplementedAntidoteServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Antidote_ServiceDesc, srv)
+}
+
+func _Antidote_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AntidoteServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Antidote_Read_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AntidoteServer).Read(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Antidote_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AntidoteServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Antidote_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AntidoteServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Antidote_Transaction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AntidoteServer).Transaction(&grpc.GenericServerStream[TransactionRequest, TransactionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Antidote_TransactionServer = grpc.BidiStreamingServer[TransactionRequest, TransactionResponse]
+
+// Antidote_ServiceDesc is the grpc.ServiceDesc for Antidote service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Antidote_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "antidote.grpc.Antidote",
+	HandlerType: (*AntidoteServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _Antidote_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Antidote_Update_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Transaction",
+			Handler:       _Antidote_Transaction_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "antidotegrpc/antidote_service.proto",
+}
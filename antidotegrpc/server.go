@@ -0,0 +1,138 @@
+// Package antidotegrpc offers the operations of an Antidote client as a gRPC service, see antidote_service.proto.
+//
+//	client, err := antidote.NewClient(antidote.Host{Name: "127.0.0.1", Port: 8087})
+//	s := grpc.NewServer()
+//	antidotegrpc.RegisterAntidoteServer(s, antidotegrpc.NewServer(client))
+//	s.Serve(listener)
+//
+// Read and Update are executed as static transactions.
+// A Transaction stream runs an interactive transaction, which is aborted if the stream ends before commit or abort.
+// Reads and updates waiting for Antidote are interrupted when the RPC is cancelled or its deadline expires;
+// an interrupted update may still have been applied, an interrupted transaction is abandoned.
+package antidotegrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Implements the Antidote service on top of a client.
+type Server struct {
+	UnimplementedAntidoteServer
+	client *antidote.Client
+}
+
+// Creates a server executing the requests with the client.
+// The client is not closed by the server.
+func NewServer(client *antidote.Client) *Server {
+	return &Server{client: client}
+}
+
+// Reads objects in a static transaction, interrupted when the context is done
+func (s *Server) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
+	if len(req.Objects) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no objects to read")
+	}
+	resp, err := s.client.CreateStaticTransaction().ReadContext(ctx, req.Objects...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &ReadResponse{Objects: resp.Objects}, nil
+}
+
+// Updates objects in a static transaction, interrupted when the context is done
+func (s *Server) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	if len(req.Updates) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no updates")
+	}
+	if err := s.client.CreateStaticTransaction().UpdateContext(ctx, req.Updates...); err != nil {
+		return nil, toStatus(err)
+	}
+	return &UpdateResponse{}, nil
+}
+
+// Runs an interactive transaction for the lifetime of the stream.
+// The transaction is aborted if the stream ends or an operation fails before commit or abort.
+// Reads and updates are interrupted when the context of the stream is done.
+func (s *Server) Transaction(stream Antidote_TransactionServer) error {
+	ctx := stream.Context()
+	tx, err := s.client.StartTransactionContext(ctx)
+	if err != nil {
+		return toStatus(err)
+	}
+	done := false
+	defer func() {
+		if !done {
+			tx.Abort()
+		}
+	}()
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return status.Error(codes.Aborted, "stream closed before commit, transaction aborted")
+		}
+		if err != nil {
+			return err
+		}
+		resp := &TransactionResponse{}
+		switch r := req.Request.(type) {
+		case *TransactionRequest_Read:
+			if len(r.Read.GetObjects()) == 0 {
+				return status.Error(codes.InvalidArgument, "no objects to read")
+			}
+			read, err := tx.ReadContext(ctx, r.Read.Objects...)
+			if err != nil {
+				return toStatus(err)
+			}
+			resp.Response = &TransactionResponse_Read{Read: &ReadResponse{Objects: read.Objects}}
+		case *TransactionRequest_Update:
+			if len(r.Update.GetUpdates()) == 0 {
+				return status.Error(codes.InvalidArgument, "no updates")
+			}
+			if err := tx.UpdateContext(ctx, r.Update.Updates...); err != nil {
+				return toStatus(err)
+			}
+			resp.Response = &TransactionResponse_Update{Update: &UpdateResponse{}}
+		case *TransactionRequest_Commit:
+			done = true
+			if err := tx.Commit(); err != nil {
+				return toStatus(err)
+			}
+			return stream.Send(&TransactionResponse{Response: &TransactionResponse_Commit{Commit: &CommitResponse{}}})
+		case *TransactionRequest_Abort:
+			done = true
+			if err := tx.Abort(); err != nil {
+				return toStatus(err)
+			}
+			return stream.Send(&TransactionResponse{Response: &TransactionResponse_Abort{Abort: &AbortResponse{}}})
+		default:
+			return status.Error(codes.InvalidArgument, "empty request")
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// Maps errors of the client to gRPC status codes.
+// Errors reported by Antidote are returned as Aborted, failures to reach Antidote as Unavailable,
+// operations interrupted by the context of the RPC as Canceled or DeadlineExceeded.
+func toStatus(err error) error {
+	var serr *antidote.ServerError
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.As(err, &serr):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, antidote.ErrTxExpired):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, antidote.ErrTxDone):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
@@ -0,0 +1,256 @@
+package antidotegrpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/internal/antidotetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// Minimal stand-in for Antidote: answers reads of counters with 7 and acknowledges all other requests.
+// Reads of objects in the bucket "fail" are answered with an ApbErrorResp, of objects in the bucket "block" once unblocked is closed.
+type fakeAntidote struct {
+	*antidotetest.Server
+	unblocked chan struct{}
+
+	mutex    sync.Mutex
+	received []antidote.MessageCode
+}
+
+func newFakeAntidote(t *testing.T) *fakeAntidote {
+	f := &fakeAntidote{unblocked: make(chan struct{})}
+	f.Server = antidotetest.NewServer(t, f.respond)
+	t.Cleanup(func() { close(f.unblocked) })
+	return f
+}
+
+func (f *fakeAntidote) codes() []antidote.MessageCode {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]antidote.MessageCode(nil), f.received...)
+}
+
+func (f *fakeAntidote) respond(_ int, code antidote.MessageCode, req proto.Message) (antidote.MessageCode, proto.Message) {
+	f.mutex.Lock()
+	f.received = append(f.received, code)
+	f.mutex.Unlock()
+	success := true
+	// returns the values of the objects, or the error Antidote answers reads in the bucket "fail" with
+	read := func(objects []*antidote.ApbBoundObject) (*antidote.ApbReadObjectsResp, *antidote.ApbErrorResp) {
+		resp := &antidote.ApbReadObjectsResp{Success: &success}
+		for _, o := range objects {
+			if string(o.Bucket) == "fail" {
+				return nil, &antidote.ApbErrorResp{Errmsg: []byte("unknown bucket"), Errcode: proto.Uint32(42)}
+			}
+			if string(o.Bucket) == "block" {
+				<-f.unblocked
+			}
+			resp.Objects = append(resp.Objects, &antidote.ApbReadObjectResp{Counter: &antidote.ApbGetCounterResp{Value: proto.Int32(7)}})
+		}
+		return resp, nil
+	}
+	switch code {
+	case antidote.MsgStaticReadObjects:
+		resp, errResp := read(req.(*antidote.ApbStaticReadObjects).Objects)
+		if errResp != nil {
+			return antidote.MsgErrorResp, errResp
+		}
+		return antidote.MsgStaticReadObjectsResp, &antidote.ApbStaticReadObjectsResp{Objects: resp, Committime: &antidote.ApbCommitResp{Success: &success}}
+	case antidote.MsgReadObjects:
+		resp, errResp := read(req.(*antidote.ApbReadObjects).Boundobjects)
+		if errResp != nil {
+			return antidote.MsgErrorResp, errResp
+		}
+		return antidote.MsgReadObjectsResp, resp
+	case antidote.MsgStartTransaction:
+		return antidote.MsgStartTransactionResp, &antidote.ApbStartTransactionResp{Success: &success, TransactionDescriptor: []byte("tx")}
+	case antidote.MsgStaticUpdateObjects, antidote.MsgCommitTransaction:
+		return antidote.MsgCommitResp, &antidote.ApbCommitResp{Success: &success, CommitTime: []byte("t")}
+	}
+	return antidote.MsgOperationResp, &antidote.ApbOperationResp{Success: &success}
+}
+
+// Serves the service with a client of a fakeAntidote and returns a connected gRPC client
+func newTestService(t *testing.T) (AntidoteClient, *fakeAntidote) {
+	fake := newFakeAntidote(t)
+	client, err := antidote.NewClient(fake.Host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+
+	l := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	RegisterAntidoteServer(s, NewServer(client))
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+	con, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { con.Close() })
+	return NewAntidoteClient(con), fake
+}
+
+func counter(bucket, key string) *antidote.ApbBoundObject {
+	return &antidote.ApbBoundObject{Bucket: []byte(bucket), Key: []byte(key), Type: antidote.CRDTType_COUNTER.Enum()}
+}
+
+func TestReadUpdate(t *testing.T) {
+	c, fake := newTestService(t)
+	ctx := context.Background()
+	resp, err := c.Read(ctx, &ReadRequest{Objects: []*antidote.ApbBoundObject{counter("b", "k")}})
+	if err != nil || len(resp.Objects) != 1 || resp.Objects[0].Counter.GetValue() != 7 {
+		t.Fatalf("unexpected read %v (%v)", resp, err)
+	}
+	update := antidote.CounterInc(antidote.Key("k"), 1).ConvertToToplevel([]byte("b"))
+	if _, err = c.Update(ctx, &UpdateRequest{Updates: []*antidote.ApbUpdateOp{update}}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []antidote.MessageCode{antidote.MsgStaticReadObjects, antidote.MsgStaticUpdateObjects}
+	if codes := fake.codes(); !bytes.Equal(toBytes(codes), toBytes(expected)) {
+		t.Fatalf("unexpected requests %v", codes)
+	}
+
+	if _, err = c.Read(ctx, &ReadRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+	// errors of Antidote are reported with their message
+	_, err = c.Read(ctx, &ReadRequest{Objects: []*antidote.ApbBoundObject{counter("fail", "k")}})
+	if status.Code(err) != codes.Aborted || !strings.Contains(status.Convert(err).Message(), "unknown bucket") {
+		t.Fatalf("expected aborted, got %v", err)
+	}
+}
+
+func TestTransactionStream(t *testing.T) {
+	c, fake := newTestService(t)
+	stream, err := c.Transaction(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := antidote.CounterInc(antidote.Key("k"), 1).ConvertToToplevel([]byte("b"))
+	for _, req := range []*TransactionRequest{
+		{Request: &TransactionRequest_Update{Update: &UpdateRequest{Updates: []*antidote.ApbUpdateOp{update}}}},
+		{Request: &TransactionRequest_Read{Read: &ReadRequest{Objects: []*antidote.ApbBoundObject{counter("b", "k")}}}},
+		{Request: &TransactionRequest_Commit{Commit: &CommitRequest{}}},
+	} {
+		if err = stream.Send(req); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = stream.Recv(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = stream.Recv(); err != io.EOF {
+		t.Fatalf("expected end of stream after commit, got %v", err)
+	}
+	expected := []antidote.MessageCode{antidote.MsgStartTransaction, antidote.MsgUpdateObjects, antidote.MsgReadObjects, antidote.MsgCommitTransaction}
+	if codes := fake.codes(); !bytes.Equal(toBytes(codes), toBytes(expected)) {
+		t.Fatalf("unexpected requests %v", codes)
+	}
+}
+
+func TestTransactionStreamAborts(t *testing.T) {
+	c, fake := newTestService(t)
+
+	// closing the stream before commit aborts the transaction
+	stream, err := c.Transaction(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.CloseSend()
+	if _, err = stream.Recv(); status.Code(err) != codes.Aborted {
+		t.Fatalf("expected aborted, got %v", err)
+	}
+
+	// so does a failed operation
+	stream, err = c.Transaction(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.Send(&TransactionRequest{Request: &TransactionRequest_Read{Read: &ReadRequest{Objects: []*antidote.ApbBoundObject{counter("fail", "k")}}}})
+	if _, err = stream.Recv(); status.Code(err) != codes.Aborted {
+		t.Fatalf("expected aborted, got %v", err)
+	}
+
+	aborts := 0
+	for _, code := range fake.codes() {
+		if code == antidote.MsgAbortTransaction {
+			aborts++
+		}
+	}
+	if aborts != 2 {
+		t.Fatalf("expected 2 aborts, got %d", aborts)
+	}
+}
+
+// Stream of a transaction with fixed requests, discarding the responses
+type fakeStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*TransactionRequest
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) Recv() (*TransactionRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *fakeStream) Send(*TransactionResponse) error {
+	return nil
+}
+
+func TestCancelledRequests(t *testing.T) {
+	fake := newFakeAntidote(t)
+	client, err := antidote.NewClient(fake.Host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+	s := NewServer(client)
+	blocked := []*antidote.ApbBoundObject{counter("block", "k")}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err = s.Read(ctx, &ReadRequest{Objects: blocked}); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	stream := &fakeStream{ctx: ctx, requests: []*TransactionRequest{{Request: &TransactionRequest_Read{Read: &ReadRequest{Objects: blocked}}}}}
+	if err = s.Transaction(stream); status.Code(err) != codes.Canceled {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+}
+
+func toBytes(codes []antidote.MessageCode) []byte {
+	res := make([]byte, len(codes))
+	for i, c := range codes {
+		res[i] = byte(c)
+	}
+	return res
+}
@@ -0,0 +1,171 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Format name in the header of archives
+	FormatName = "antidote-export"
+	// Version of the archive format written
+	Version = 1
+)
+
+// Encoding of an archive
+type Format int
+
+const (
+	// One JSON object per line, in the JSON mapping of protocol buffers
+	JSONLines Format = iota
+	// Length-delimited protocol-buffer messages
+	Protobuf
+)
+
+// Maximum encoded size in bytes of the records read by a Reader created with NewReader
+const DefaultMaxRecordSize = 16 << 20
+
+// Returned when reading a stream that is not an archive of a supported version
+var ErrInvalidArchive = errors.New("backup: not an archive or unsupported version")
+
+// Returned when reading a record larger than the maximum size of the Reader
+var ErrRecordTooLarge = errors.New("backup: record exceeds maximum size")
+
+// Writes archives: a Header followed by a Record for every object.
+type Writer struct {
+	w      *bufio.Writer
+	format Format
+}
+
+// Creates a writer and writes the header of the archive.
+func NewWriter(w io.Writer, format Format) (*Writer, error) {
+	aw := &Writer{w: bufio.NewWriter(w), format: format}
+	header := &Header{Format: proto.String(FormatName), Version: proto.Uint32(Version), Created: proto.Int64(time.Now().UnixNano())}
+	if err := aw.write(header); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+// Adds an object and its value to the archive
+func (w *Writer) Write(object *antidote.ApbBoundObject, value *antidote.ApbReadObjectResp) error {
+	return w.write(&Record{Object: object, Value: value})
+}
+
+func (w *Writer) write(msg proto.Message) error {
+	if w.format == Protobuf {
+		_, err := protodelim.MarshalTo(w.w, msg)
+		return err
+	}
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err = w.w.Write(b); err != nil {
+		return err
+	}
+	return w.w.WriteByte('\n')
+}
+
+// Writes buffered records to the underlying writer
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Reads archives written by a Writer, detecting the format.
+type Reader struct {
+	r       *bufio.Reader
+	format  Format
+	header  *Header
+	maxSize int
+}
+
+// Creates a reader and reads the header of the archive.
+// Fails with ErrInvalidArchive if the header is missing or of an unsupported version.
+// Records larger than DefaultMaxRecordSize are rejected, see NewReaderSize.
+func NewReader(r io.Reader) (*Reader, error) {
+	return NewReaderSize(r, DefaultMaxRecordSize)
+}
+
+// Creates a reader like NewReader, rejecting records whose encoding exceeds maxRecordSize bytes with ErrRecordTooLarge.
+// The limit protects against corrupt or hostile archives; 0 or less selects DefaultMaxRecordSize.
+func NewReaderSize(r io.Reader, maxRecordSize int) (*Reader, error) {
+	if maxRecordSize <= 0 {
+		maxRecordSize = DefaultMaxRecordSize
+	}
+	ar := &Reader{r: bufio.NewReader(r), format: Protobuf, maxSize: maxRecordSize}
+	// JSON objects start with a brace, headers in protocol buffers with their length, which is less than 123
+	if first, err := ar.r.Peek(1); err == nil && first[0] == '{' {
+		ar.format = JSONLines
+	}
+	header := &Header{}
+	if err := ar.read(header); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if header.GetFormat() != FormatName || header.GetVersion() != Version {
+		return nil, fmt.Errorf("%w: %s version %d", ErrInvalidArchive, header.GetFormat(), header.GetVersion())
+	}
+	ar.header = header
+	return ar, nil
+}
+
+// Returns the header of the archive
+func (r *Reader) Header() *Header {
+	return r.header
+}
+
+// Returns the format of the archive
+func (r *Reader) Format() Format {
+	return r.format
+}
+
+// Returns the next record; io.EOF at the end of the archive
+func (r *Reader) Read() (*Record, error) {
+	record := &Record{}
+	if err := r.read(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (r *Reader) read(msg proto.Message) error {
+	if r.format == Protobuf {
+		err := protodelim.UnmarshalOptions{MaxSize: int64(r.maxSize)}.UnmarshalFrom(r.r, msg)
+		if errors.As(err, new(*protodelim.SizeTooLargeError)) {
+			return fmt.Errorf("%w: %v", ErrRecordTooLarge, err)
+		}
+		return err
+	}
+	for {
+		line, err := r.readLine()
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			return protojson.Unmarshal(line, msg)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Reads a line, failing with ErrRecordTooLarge once it exceeds the maximum size
+func (r *Reader) readLine() (line []byte, err error) {
+	for {
+		chunk, err := r.r.ReadSlice('\n')
+		if len(line)+len(chunk) > r.maxSize+1 {
+			return nil, fmt.Errorf("%w: more than %d bytes", ErrRecordTooLarge, r.maxSize)
+		}
+		line = append(line, chunk...)
+		if err != bufio.ErrBufferFull {
+			return line, err
+		}
+	}
+}
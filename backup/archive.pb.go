@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: backup/archive.proto
+
+package backup
+
+import (
+	antidote_go_client "github.com/AntidoteDB/antidote-go-client"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// First message of an archive
+type Header struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Always "antidote-export"
+	Format *string `protobuf:"bytes,1,req,name=format" json:"format,omitempty"`
+	// Version of the archive format
+	Version *uint32 `protobuf:"varint,2,req,name=version" json:"version,omitempty"`
+	// Creation time of the archive in nanoseconds since the Unix epoch
+	Created       *int64 `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Header) Reset() {
+	*x = Header{}
+	mi := &file_backup_archive_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Header) ProtoMessage() {}
+
+func (x *Header) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_archive_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Header.ProtoReflect.Descriptor instead.
+func (*Header) Descriptor() ([]byte, []int) {
+	return file_backup_archive_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Header) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return ""
+}
+
+func (x *Header) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *Header) GetCreated() int64 {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	return 0
+}
+
+// An exported object and its value
+type Record struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Object        *antidote_go_client.ApbBoundObject    `protobuf:"bytes,1,req,name=object" json:"object,omitempty"`
+	Value         *antidote_go_client.ApbReadObjectResp `protobuf:"bytes,2,req,name=value" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_backup_archive_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_archive_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_backup_archive_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Record) GetObject() *antidote_go_client.ApbBoundObject {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *Record) GetValue() *antidote_go_client.ApbReadObjectResp {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_backup_archive_proto protoreflect.FileDescriptor
+
+const file_backup_archive_proto_rawDesc = "" +
+	"\n" +
+	"\x14backup/archive.proto\x12\x0fantidote.backup\x1a\x0eantidote.proto\"T\n" +
+	"\x06Header\x12\x16\n" +
+	"\x06format\x18\x01 \x02(\tR\x06format\x12\x18\n" +
+	"\aversion\x18\x02 \x02(\rR\aversion\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x03R\acreated\"[\n" +
+	"\x06Record\x12'\n" +
+	"\x06object\x18\x01 \x02(\v2\x0f.ApbBoundObjectR\x06object\x12(\n" +
+	"\x05value\x18\x02 \x02(\v2\x12.ApbReadObjectRespR\x05valueB1Z/github.com/AntidoteDB/antidote-go-client/backup"
+
+var (
+	file_backup_archive_proto_rawDescOnce sync.Once
+	file_backup_archive_proto_rawDescData []byte
+)
+
+func file_backup_archive_proto_rawDescGZIP() []byte {
+	file_backup_archive_proto_rawDescOnce.Do(func() {
+		file_backup_archive_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backup_archive_proto_rawDesc), len(file_backup_archive_proto_rawDesc)))
+	})
+	return file_backup_archive_proto_rawDescData
+}
+
+var file_backup_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_backup_archive_proto_goTypes = []any{
+	(*Header)(nil), // 0: antidote.backup.Header
+	(*Record)(nil), // 1: antidote.backup.Record
+	(*antidote_go_client.ApbBoundObject)(nil),    // 2: ApbBoundObject
+	(*antidote_go_client.ApbReadObjectResp)(nil), // 3: ApbReadObjectResp
+}
+var file_backup_archive_proto_depIdxs = []int32{
+	2, // 0: antidote.backup.Record.object:type_name -> ApbBoundObject
+	3, // 1: antidote.backup.Record.value:type_name -> ApbReadObjectResp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_backup_archive_proto_init() }
+func file_backup_archive_proto_init() {
+	if File_backup_archive_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backup_archive_proto_rawDesc), len(file_backup_archive_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_backup_archive_proto_goTypes,
+		DependencyIndexes: file_backup_archive_proto_depIdxs,
+		MessageInfos:      file_backup_archive_proto_msgTypes,
+	}.Build()
+	File_backup_archive_proto = out.File
+	file_backup_archive_proto_goTypes = nil
+	file_backup_archive_proto_depIdxs = nil
+}
@@ -0,0 +1,283 @@
+// Package backup exports objects from Antidote into archives and imports them again.
+//
+// Export reads the objects in batches, each batch in its own interactive transaction and therefore from a consistent snapshot,
+// and writes them with their values into an archive of JSON lines or length-delimited protocol buffers.
+// Different batches may see different snapshots unless Options.Snapshot reads all of them in one transaction:
+//
+//	tx, err := client.StartTransaction()
+//	objects, err := backup.ReadIndex(tx, []byte("bucket"), antidote.Key("index"))
+//	err = tx.Commit()
+//	n, err := backup.Export(client, file, objects, backup.Options{})
+//
+// Import replays the values of an archive as updates, restoring counters, sets, registers, flags and nested maps:
+//
+//	n, err := backup.Import(client, file, backup.Options{})
+//
+// The updates are applied on top of the current state, importing into an empty bucket restores the exported values.
+// Concurrent values of multi-value registers and bounded counters are not restored, see Updates.
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+)
+
+// Number of objects read or updated per transaction if Options.BatchSize is not set
+const DefaultBatchSize = 100
+
+// Settings of Export and Import
+type Options struct {
+	// Number of objects read per transaction when exporting and updated per transaction when importing
+	BatchSize int
+	// Encoding of exported archives; imports detect the encoding
+	Format Format
+	// Export all batches from one snapshot, reading them in a single interactive transaction.
+	// The transaction stays open for the whole export, which should be shorter than the maximum lifetime of transactions of the client.
+	Snapshot bool
+	// Maximum encoded size in bytes of the records read when importing, defaults to DefaultMaxRecordSize
+	MaxRecordSize int
+}
+
+func (o Options) batchSize() int {
+	if o.BatchSize <= 0 {
+		return DefaultBatchSize
+	}
+	return o.BatchSize
+}
+
+// The operations of a client or of the handle of a single host used to export and import
+type Client interface {
+	StartTransaction() (*antidote.InteractiveTransaction, error)
+	CreateStaticTransaction() *antidote.StaticTransaction
+}
+
+// Writes the objects and their values into an archive written to w.
+// The objects of a batch are read in one interactive transaction, and thus from a consistent snapshot;
+// the archive as a whole is only consistent with options.Snapshot, which reads all batches in the same transaction.
+// Returns the number of objects written.
+func Export(client Client, w io.Writer, objects []*antidote.ApbBoundObject, options Options) (n int, err error) {
+	aw, err := NewWriter(w, options.Format)
+	if err != nil {
+		return
+	}
+	var snapshot *antidote.InteractiveTransaction
+	if options.Snapshot {
+		if snapshot, err = client.StartTransaction(); err != nil {
+			return
+		}
+		defer func() {
+			if err != nil {
+				snapshot.Abort()
+			}
+		}()
+	}
+	size := options.batchSize()
+	for start := 0; start < len(objects); start += size {
+		batch := objects[start:min(start+size, len(objects))]
+		var values []*antidote.ApbReadObjectResp
+		if snapshot != nil {
+			values, err = read(snapshot, batch)
+		} else {
+			values, err = readBatch(client, batch)
+		}
+		if err != nil {
+			return
+		}
+		for i, object := range batch {
+			if err = aw.Write(object, values[i]); err != nil {
+				return
+			}
+			n++
+		}
+	}
+	if err = aw.Flush(); err != nil || snapshot == nil {
+		return
+	}
+	return n, snapshot.Commit()
+}
+
+// Reads the objects in an interactive transaction of their own
+func readBatch(client Client, objects []*antidote.ApbBoundObject) ([]*antidote.ApbReadObjectResp, error) {
+	tx, err := client.StartTransaction()
+	if err != nil {
+		return nil, err
+	}
+	values, err := read(tx, objects)
+	if err != nil {
+		tx.Abort()
+		return nil, err
+	}
+	return values, tx.Commit()
+}
+
+// Reads the objects in the transaction
+func read(tx *antidote.InteractiveTransaction, objects []*antidote.ApbBoundObject) ([]*antidote.ApbReadObjectResp, error) {
+	resp, err := tx.Read(objects...)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Objects) != len(objects) {
+		return nil, fmt.Errorf("backup: read %d objects, got %d values", len(objects), len(resp.Objects))
+	}
+	return resp.Objects, nil
+}
+
+// Reads the objects listed in an add-wins set, e.g. maintained by the application next to the objects.
+// Elements are of the form type:key, with the name of the type as in CRDTType, case-insensitive, e.g. counter:visits or rrmap:user/1.
+// The objects are in the bucket of the set.
+func ReadIndex(tx antidote.Transaction, bucket []byte, key antidote.Key) ([]*antidote.ApbBoundObject, error) {
+	b := antidote.Bucket{Bucket: bucket}
+	elems, err := b.ReadSet(tx, key)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]*antidote.ApbBoundObject, len(elems))
+	for i, e := range elems {
+		if objects[i], err = ParseObject(bucket, string(e)); err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// Parses an object of the form type:key in the bucket, see ReadIndex
+func ParseObject(bucket []byte, s string) (*antidote.ApbBoundObject, error) {
+	name, key, ok := strings.Cut(s, ":")
+	t, known := antidote.CRDTType_value[strings.ToUpper(name)]
+	if !ok || !known {
+		return nil, fmt.Errorf("backup: invalid object %q, expected type:key", s)
+	}
+	crdtType := antidote.CRDTType(t)
+	return &antidote.ApbBoundObject{Bucket: bucket, Key: antidote.Key(key), Type: &crdtType}, nil
+}
+
+// Returned by Updates for objects whose values cannot be restored by updates
+var ErrUnsupportedType = errors.New("backup: type cannot be restored")
+
+// Returns the update restoring the value of a record on an empty object, nil if the value is empty.
+// Counters are incremented by their value, elements are added to sets, registers are written
+// and enabled flags enabled; maps are updated recursively.
+// Of the concurrent values of a multi-value register only the first is written.
+// Bounded counters cannot be restored and result in ErrUnsupportedType.
+func Updates(record *Record) (*antidote.CRDTUpdate, error) {
+	return restore(record.Object.Key, record.Object.GetType(), record.Value)
+}
+
+func restore(key antidote.Key, t antidote.CRDTType, value *antidote.ApbReadObjectResp) (*antidote.CRDTUpdate, error) {
+	update := &antidote.CRDTUpdate{Key: key, Type: t, Update: &antidote.ApbUpdateOperation{}}
+	mismatch := fmt.Errorf("backup: value of %s %q does not match type", t, key)
+	switch t {
+	case antidote.CRDTType_COUNTER, antidote.CRDTType_FATCOUNTER:
+		if value.Counter == nil {
+			return nil, mismatch
+		}
+		if value.Counter.GetValue() == 0 {
+			return nil, nil
+		}
+		inc := int64(value.Counter.GetValue())
+		update.Update.Counterop = &antidote.ApbCounterUpdate{Inc: &inc}
+	case antidote.CRDTType_ORSET, antidote.CRDTType_RWSET:
+		if value.Set == nil {
+			return nil, mismatch
+		}
+		if len(value.Set.Value) == 0 {
+			return nil, nil
+		}
+		optype := antidote.ApbSetUpdate_ADD
+		update.Update.Setop = &antidote.ApbSetUpdate{Optype: &optype, Adds: value.Set.Value}
+	case antidote.CRDTType_LWWREG:
+		if value.Reg == nil {
+			return nil, mismatch
+		}
+		if len(value.Reg.Value) == 0 {
+			return nil, nil
+		}
+		update.Update.Regop = &antidote.ApbRegUpdate{Value: value.Reg.Value}
+	case antidote.CRDTType_MVREG:
+		if value.Mvreg == nil {
+			return nil, mismatch
+		}
+		if len(value.Mvreg.Values) == 0 {
+			return nil, nil
+		}
+		update.Update.Regop = &antidote.ApbRegUpdate{Value: value.Mvreg.Values[0]}
+	case antidote.CRDTType_FLAG_EW, antidote.CRDTType_FLAG_DW:
+		if value.Flag == nil {
+			return nil, mismatch
+		}
+		if !value.Flag.GetValue() {
+			return nil, nil
+		}
+		update.Update.Flagop = &antidote.ApbFlagUpdate{Value: value.Flag.Value}
+	case antidote.CRDTType_RRMAP, antidote.CRDTType_GMAP:
+		if value.Map == nil {
+			return nil, mismatch
+		}
+		var nested []*antidote.ApbMapNestedUpdate
+		for _, e := range value.Map.Entries {
+			u, err := restore(e.Key.Key, e.Key.GetType(), e.Value)
+			if err != nil {
+				return nil, err
+			}
+			if u != nil {
+				nested = append(nested, u.ConvertToNested())
+			}
+		}
+		if len(nested) == 0 {
+			return nil, nil
+		}
+		update.Update.Mapop = &antidote.ApbMapUpdate{Updates: nested}
+	default:
+		return nil, fmt.Errorf("%w: %s %q", ErrUnsupportedType, t, key)
+	}
+	return update, nil
+}
+
+// Reads an archive from r and applies the updates restoring its objects, see Updates.
+// The updates of a batch are applied in one static transaction.
+// Returns the number of objects updated; objects with empty values are skipped.
+func Import(client Client, r io.Reader, options Options) (n int, err error) {
+	ar, err := NewReaderSize(r, options.MaxRecordSize)
+	if err != nil {
+		return
+	}
+	size := options.batchSize()
+	var batch []*antidote.ApbUpdateOp
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := client.CreateStaticTransaction().Update(batch...); err != nil {
+			return err
+		}
+		n += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+	for {
+		record, err := ar.Read()
+		if err == io.EOF {
+			return n, flush()
+		}
+		if err != nil {
+			return n, err
+		}
+		update, err := Updates(record)
+		if err != nil {
+			return n, err
+		}
+		if update == nil {
+			continue
+		}
+		batch = append(batch, update.ConvertToToplevel(record.Object.Bucket))
+		if len(batch) == size {
+			if err = flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+}
@@ -0,0 +1,320 @@
+package backup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/internal/antidotetest"
+	"google.golang.org/protobuf/proto"
+)
+
+// Minimal stand-in for Antidote storing objects of all types but bounded counters
+type fakeAntidote struct {
+	*antidotetest.Server
+
+	mutex   sync.Mutex
+	objects map[string]*antidote.ApbReadObjectResp
+	// number of requests received by message code
+	requests map[antidote.MessageCode]int
+}
+
+func newFakeAntidote(t *testing.T) *fakeAntidote {
+	f := &fakeAntidote{objects: make(map[string]*antidote.ApbReadObjectResp), requests: make(map[antidote.MessageCode]int)}
+	f.Server = antidotetest.NewServer(t, f.respond)
+	return f
+}
+
+func (f *fakeAntidote) client(t *testing.T) *antidote.Client {
+	client, err := antidote.NewClient(f.Host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func objectID(o *antidote.ApbBoundObject) string {
+	return fmt.Sprintf("%s/%s/%s", o.Bucket, o.Key, o.GetType())
+}
+
+// Returns the value of an object without updates
+func emptyValue(t antidote.CRDTType) *antidote.ApbReadObjectResp {
+	switch t {
+	case antidote.CRDTType_COUNTER, antidote.CRDTType_FATCOUNTER:
+		return &antidote.ApbReadObjectResp{Counter: &antidote.ApbGetCounterResp{Value: proto.Int32(0)}}
+	case antidote.CRDTType_ORSET, antidote.CRDTType_RWSET:
+		return &antidote.ApbReadObjectResp{Set: &antidote.ApbGetSetResp{}}
+	case antidote.CRDTType_LWWREG:
+		return &antidote.ApbReadObjectResp{Reg: &antidote.ApbGetRegResp{Value: []byte{}}}
+	case antidote.CRDTType_MVREG:
+		return &antidote.ApbReadObjectResp{Mvreg: &antidote.ApbGetMVRegResp{}}
+	case antidote.CRDTType_FLAG_EW, antidote.CRDTType_FLAG_DW:
+		return &antidote.ApbReadObjectResp{Flag: &antidote.ApbGetFlagResp{Value: proto.Bool(false)}}
+	case antidote.CRDTType_RRMAP, antidote.CRDTType_GMAP:
+		return &antidote.ApbReadObjectResp{Map: &antidote.ApbGetMapResp{}}
+	}
+	return nil
+}
+
+// Applies an update operation to a value
+func apply(value *antidote.ApbReadObjectResp, t antidote.CRDTType, op *antidote.ApbUpdateOperation) {
+	switch {
+	case op.Counterop != nil:
+		value.Counter.Value = proto.Int32(value.Counter.GetValue() + int32(op.Counterop.GetInc()))
+	case op.Setop != nil:
+		for _, e := range op.Setop.Adds {
+			if !containsElem(value.Set.Value, e) {
+				value.Set.Value = append(value.Set.Value, e)
+			}
+		}
+	case op.Regop != nil && t == antidote.CRDTType_MVREG:
+		value.Mvreg.Values = [][]byte{op.Regop.Value}
+	case op.Regop != nil:
+		value.Reg.Value = op.Regop.Value
+	case op.Flagop != nil:
+		value.Flag.Value = op.Flagop.Value
+	case op.Mapop != nil:
+		for _, u := range op.Mapop.Updates {
+			var entry *antidote.ApbMapEntry
+			for _, e := range value.Map.Entries {
+				if bytes.Equal(e.Key.Key, u.Key.Key) && e.Key.GetType() == u.Key.GetType() {
+					entry = e
+				}
+			}
+			if entry == nil {
+				entry = &antidote.ApbMapEntry{Key: u.Key, Value: emptyValue(u.Key.GetType())}
+				value.Map.Entries = append(value.Map.Entries, entry)
+			}
+			apply(entry.Value, u.Key.GetType(), u.Update)
+		}
+	}
+}
+
+func containsElem(elems [][]byte, elem []byte) bool {
+	for _, e := range elems {
+		if bytes.Equal(e, elem) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeAntidote) read(objects []*antidote.ApbBoundObject) *antidote.ApbReadObjectsResp {
+	resp := &antidote.ApbReadObjectsResp{Success: proto.Bool(true)}
+	for _, o := range objects {
+		value, ok := f.objects[objectID(o)]
+		if !ok {
+			value = emptyValue(o.GetType())
+		}
+		resp.Objects = append(resp.Objects, proto.Clone(value).(*antidote.ApbReadObjectResp))
+	}
+	return resp
+}
+
+func (f *fakeAntidote) update(updates []*antidote.ApbUpdateOp) {
+	for _, u := range updates {
+		id := objectID(u.Boundobject)
+		if _, ok := f.objects[id]; !ok {
+			f.objects[id] = emptyValue(u.Boundobject.GetType())
+		}
+		apply(f.objects[id], u.Boundobject.GetType(), u.Operation)
+	}
+}
+
+func (f *fakeAntidote) respond(_ int, code antidote.MessageCode, req proto.Message) (antidote.MessageCode, proto.Message) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.requests[code]++
+	success := proto.Bool(true)
+	commit := &antidote.ApbCommitResp{Success: success, CommitTime: []byte("time")}
+	switch r := req.(type) {
+	case *antidote.ApbStaticUpdateObjects:
+		f.update(r.Updates)
+		return antidote.MsgCommitResp, commit
+	case *antidote.ApbStaticReadObjects:
+		return antidote.MsgStaticReadObjectsResp, &antidote.ApbStaticReadObjectsResp{Objects: f.read(r.Objects), Committime: commit}
+	case *antidote.ApbStartTransaction:
+		return antidote.MsgStartTransactionResp, &antidote.ApbStartTransactionResp{Success: success, TransactionDescriptor: []byte("tx")}
+	case *antidote.ApbReadObjects:
+		return antidote.MsgReadObjectsResp, f.read(r.Boundobjects)
+	case *antidote.ApbUpdateObjects:
+		f.update(r.Updates)
+		return antidote.MsgOperationResp, &antidote.ApbOperationResp{Success: success}
+	case *antidote.ApbCommitTransaction:
+		return antidote.MsgCommitResp, commit
+	}
+	return antidote.MsgOperationResp, &antidote.ApbOperationResp{Success: success}
+}
+
+func (f *fakeAntidote) requestCount(code antidote.MessageCode) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.requests[code]
+}
+
+// Populates a bucket with objects of all restorable types and an index listing them
+func populate(t *testing.T, client *antidote.Client) []*antidote.ApbBoundObject {
+	bucket := antidote.Bucket{Bucket: []byte("bucket")}
+	err := bucket.Update(client.CreateStaticTransaction(),
+		antidote.CounterInc(antidote.Key("visits"), 42),
+		antidote.SetAdd(antidote.Key("tags"), []byte("a"), []byte("b")),
+		antidote.RegPut(antidote.Key("name"), []byte("antidote")),
+		antidote.MVRegPut(antidote.Key("title"), []byte("backup")),
+		antidote.FlagPut(antidote.Key("enabled"), true),
+		antidote.MapUpdate(antidote.Key("user"),
+			antidote.RegPut(antidote.Key("name"), []byte("Annette")),
+			antidote.CounterInc(antidote.Key("logins"), 3),
+			antidote.MapUpdate(antidote.Key("address"),
+				antidote.RegPut(antidote.Key("city"), []byte("Kaiserslautern")),
+				antidote.SetAdd(antidote.Key("phones"), []byte("123"), []byte("456")),
+			),
+		),
+		antidote.SetAdd(antidote.Key("index"), []byte("counter:visits"), []byte("orset:tags"), []byte("lwwreg:name"),
+			[]byte("mvreg:title"), []byte("flag_ew:enabled"), []byte("RRMap:user"), []byte("counter:missing")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	objects, err := ReadIndex(tx, bucket.Bucket, antidote.Key("index"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 7 || string(objects[5].Key) != "user" || objects[5].GetType() != antidote.CRDTType_RRMAP {
+		t.Fatalf("unexpected objects %v", objects)
+	}
+	return objects
+}
+
+// Reads all records of an archive
+func readArchive(t *testing.T, archive []byte, format Format) []*Record {
+	ar, err := NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ar.Format() != format || ar.Header().GetCreated() == 0 {
+		t.Fatalf("unexpected format %d, header %v", ar.Format(), ar.Header())
+	}
+	var records []*Record
+	for {
+		record, err := ar.Read()
+		if err != nil {
+			break
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestExportImport(t *testing.T) {
+	for _, format := range []Format{JSONLines, Protobuf} {
+		source := newFakeAntidote(t)
+		objects := populate(t, source.client(t))
+		transactions := source.requestCount(antidote.MsgStartTransaction)
+
+		archive := &bytes.Buffer{}
+		n, err := Export(source.client(t), archive, objects, Options{BatchSize: 3, Format: format})
+		if err != nil || n != 7 {
+			t.Fatalf("exported %d objects (%v)", n, err)
+		}
+		// the objects are read in three transactions of up to three objects
+		if source.requestCount(antidote.MsgStartTransaction)-transactions != 3 || source.requestCount(antidote.MsgReadObjects) != 4 {
+			t.Fatalf("unexpected transactions: %v", source.requests)
+		}
+		exported := append([]byte(nil), archive.Bytes()...)
+		records := readArchive(t, exported, format)
+		if len(records) != 7 || !proto.Equal(records[0].Object, objects[0]) || records[0].Value.Counter.GetValue() != 42 {
+			t.Fatalf("unexpected records %v", records)
+		}
+
+		// from a single snapshot, all batches are read in one transaction
+		transactions = source.requestCount(antidote.MsgStartTransaction)
+		archive.Reset()
+		if n, err = Export(source.client(t), archive, objects, Options{BatchSize: 3, Format: format, Snapshot: true}); err != nil || n != 7 {
+			t.Fatalf("exported %d objects (%v)", n, err)
+		}
+		if source.requestCount(antidote.MsgStartTransaction)-transactions != 1 || source.requestCount(antidote.MsgReadObjects) != 7 {
+			t.Fatalf("unexpected transactions: %v", source.requests)
+		}
+		for i, record := range readArchive(t, archive.Bytes(), format) {
+			if !proto.Equal(record, records[i]) {
+				t.Fatalf("expected %v, got %v", records[i], record)
+			}
+		}
+
+		// importing into an empty instance restores all values but the one of the missing counter
+		target := newFakeAntidote(t)
+		if n, err = Import(target.client(t), bytes.NewReader(exported), Options{BatchSize: 4}); err != nil || n != 6 {
+			t.Fatalf("imported %d objects (%v)", n, err)
+		}
+		if target.requestCount(antidote.MsgStaticUpdateObjects) != 2 {
+			t.Fatalf("unexpected transactions: %v", target.requests)
+		}
+		archive.Reset()
+		if _, err = Export(target.client(t), archive, objects, Options{Format: format}); err != nil {
+			t.Fatal(err)
+		}
+		restored := readArchive(t, archive.Bytes(), format)
+		for i := range records {
+			if !proto.Equal(records[i], restored[i]) {
+				t.Fatalf("expected %v, got %v", records[i], restored[i])
+			}
+		}
+	}
+}
+
+func TestInvalidArchives(t *testing.T) {
+	client := newFakeAntidote(t).client(t)
+	if _, err := Import(client, bytes.NewReader([]byte(`{"format":"antidote-export","version":2}`+"\n")), Options{}); !errors.Is(err, ErrInvalidArchive) {
+		t.Fatalf("expected invalid archive, got %v", err)
+	}
+	if _, err := Import(client, bytes.NewReader([]byte("not an archive")), Options{}); !errors.Is(err, ErrInvalidArchive) {
+		t.Fatalf("expected invalid archive, got %v", err)
+	}
+
+	archive := &bytes.Buffer{}
+	w, err := NewWriter(archive, JSONLines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crdtType := antidote.CRDTType_BCOUNTER
+	object := &antidote.ApbBoundObject{Bucket: []byte("bucket"), Key: []byte("stock"), Type: &crdtType}
+	if err = w.Write(object, &antidote.ApbReadObjectResp{Counter: &antidote.ApbGetCounterResp{Value: proto.Int32(5)}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Import(client, archive, Options{}); !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("expected unsupported type, got %v", err)
+	}
+
+	// records exceeding the maximum size are rejected before they are read
+	for _, format := range []Format{JSONLines, Protobuf} {
+		archive.Reset()
+		if w, err = NewWriter(archive, format); err != nil {
+			t.Fatal(err)
+		}
+		crdtType = antidote.CRDTType_LWWREG
+		object = &antidote.ApbBoundObject{Bucket: []byte("bucket"), Key: []byte("reg"), Type: &crdtType}
+		if err = w.Write(object, &antidote.ApbReadObjectResp{Reg: &antidote.ApbGetRegResp{Value: bytes.Repeat([]byte("x"), 200)}}); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = Import(client, bytes.NewReader(archive.Bytes()), Options{MaxRecordSize: 100}); !errors.Is(err, ErrRecordTooLarge) {
+			t.Fatalf("expected record too large, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Package cluster sets up geo-replication between Antidote data centers.
+//
+// Given the topology of the cluster, an Orchestrator creates each data center from its nodes,
+// collects the connection descriptors of all data centers and connects every data center to all of them:
+//
+//	o, err := cluster.New(cluster.Topology{DCs: []cluster.DC{
+//		{Name: "dc1", Hosts: []antidote.Host{{"10.0.0.1", 8087}}, Nodes: []string{"antidote@10.0.0.1", "antidote@10.0.0.2"}},
+//		{Name: "dc2", Hosts: []antidote.Host{{"10.0.1.1", 8087}}, Nodes: []string{"antidote@10.0.1.1"}},
+//	}}, cluster.Options{})
+//	report, err := o.Bootstrap(ctx)
+//
+// Failed steps are retried. Steps that succeeded are remembered by the Orchestrator,
+// calling Bootstrap again after a failure only performs the remaining steps.
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+)
+
+// Describes a data center of the cluster.
+type DC struct {
+	// Identifies the data center in reports and errors
+	Name string
+	// Protocol-buffer interfaces of the nodes of the data center.
+	// Each request is sent to one of them, attempts of a step try them in turn
+	Hosts []antidote.Host
+	// Erlang names of the nodes forming the data center, e.g. antidote@10.0.0.1.
+	// The data center is not created if empty, e.g. for data centers consisting of a single node.
+	Nodes []string
+}
+
+// Describes the data centers to connect.
+type Topology struct {
+	DCs []DC
+}
+
+// Optional settings of an Orchestrator.
+type Options struct {
+	// Number of attempts of each step, defaults to DefaultAttempts
+	Attempts int
+	// Time to wait between attempts, defaults to DefaultRetryDelay
+	RetryDelay time.Duration
+	// Options of the clients connecting to the data centers
+	ClientOptions antidote.ClientOptions
+	// Called after each step, e.g. to log the progress; may be nil
+	OnStep func(result StepResult)
+}
+
+const (
+	DefaultAttempts   = 3
+	DefaultRetryDelay = time.Second
+)
+
+// A step of setting up the cluster, performed for each data center
+type Step string
+
+const (
+	// Create the data center from its nodes
+	StepCreateDC Step = "create_dc"
+	// Get the connection descriptor of the data center
+	StepGetDescriptor Step = "get_connection_descriptor"
+	// Connect the data center to all data centers
+	StepConnect Step = "connect_to_dcs"
+)
+
+// Result of a step for a data center.
+type StepResult struct {
+	Step Step
+	DC   string
+	// Number of attempts made, 0 if the step was skipped
+	Attempts int
+	// Set if the step was not performed, as it succeeded in a previous call to Bootstrap
+	// or the data center has no nodes to create it from
+	Skipped bool
+	// Error of the last attempt, nil if the step succeeded
+	Err error
+}
+
+// Results of the steps performed by Bootstrap, in order.
+type Report struct {
+	Steps []StepResult
+}
+
+// Returned by Bootstrap for each step that failed after all attempts.
+type StepError struct {
+	Step     Step
+	DC       string
+	Attempts int
+	Err      error
+}
+
+func (err *StepError) Error() string {
+	return fmt.Sprintf("cluster: %s of %s failed after %d attempts: %v", err.Step, err.DC, err.Attempts, err.Err)
+}
+
+func (err *StepError) Unwrap() error {
+	return err.Err
+}
+
+// Sets up the replication between the data centers of a topology.
+// Safe for concurrent use; calls to Bootstrap are serialized.
+type Orchestrator struct {
+	topology Topology
+	options  Options
+
+	mutex       sync.Mutex
+	created     map[string]bool
+	descriptors map[string][]byte
+	connected   map[string]bool
+}
+
+// Creates an orchestrator for the topology.
+// Fails if data centers have no name, no hosts or duplicate names.
+func New(topology Topology, options Options) (*Orchestrator, error) {
+	names := make(map[string]bool)
+	for _, dc := range topology.DCs {
+		if dc.Name == "" {
+			return nil, errors.New("cluster: data center without name")
+		}
+		if names[dc.Name] {
+			return nil, fmt.Errorf("cluster: duplicate data center %s", dc.Name)
+		}
+		if len(dc.Hosts) == 0 {
+			return nil, fmt.Errorf("cluster: data center %s has no hosts", dc.Name)
+		}
+		names[dc.Name] = true
+	}
+	if options.Attempts <= 0 {
+		options.Attempts = DefaultAttempts
+	}
+	if options.RetryDelay <= 0 {
+		options.RetryDelay = DefaultRetryDelay
+	}
+	return &Orchestrator{
+		topology:    topology,
+		options:     options,
+		created:     make(map[string]bool),
+		descriptors: make(map[string][]byte),
+		connected:   make(map[string]bool),
+	}, nil
+}
+
+// Creates the data centers, collects their connection descriptors and connects them with each other.
+// Each step is attempted up to Options.Attempts times.
+// When the context is done, waiting for a retry and requests in progress are interrupted.
+// The data centers are only connected once all descriptors are known;
+// the returned error joins a *StepError for every failed step and the error of the context if it is done.
+func (o *Orchestrator) Bootstrap(ctx context.Context) (report Report, err error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	// one client per host, so that an unreachable host does not prevent reaching the others
+	clients := make(map[antidote.Host]*antidote.Client)
+	defer func() {
+		for _, c := range clients {
+			c.Close()
+		}
+	}()
+	client := func(host antidote.Host) (*antidote.HostClient, error) {
+		c, ok := clients[host]
+		if !ok {
+			var err error
+			if c, err = antidote.NewClientWithOptions(o.options.ClientOptions, host); err != nil {
+				return nil, err
+			}
+			clients[host] = c
+		}
+		return c.OnHost(host)
+	}
+
+	var errs []error
+	// performs a step for every data center, done reports whether the step succeeded before
+	phase := func(step Step, done func(dc DC) bool, do func(hc *antidote.HostClient, dc DC) error) {
+		for _, dc := range o.topology.DCs {
+			if ctx.Err() != nil {
+				return
+			}
+			if done(dc) {
+				o.record(&report, StepResult{Step: step, DC: dc.Name, Skipped: true})
+				continue
+			}
+			result := o.perform(ctx, step, dc, func(attempt int) error {
+				// target a single host, so that all requests of a step reach the same data center,
+				// and try the next host of the data center on the next attempt
+				hc, err := client(dc.Hosts[(attempt-1)%len(dc.Hosts)])
+				if err != nil {
+					return err
+				}
+				return do(hc, dc)
+			})
+			o.record(&report, result)
+			if result.Err != nil {
+				errs = append(errs, &StepError{Step: step, DC: dc.Name, Attempts: result.Attempts, Err: result.Err})
+			}
+		}
+	}
+
+	phase(StepCreateDC, func(dc DC) bool { return len(dc.Nodes) == 0 || o.created[dc.Name] }, func(hc *antidote.HostClient, dc DC) error {
+		err := hc.CreateDcContext(ctx, dc.Nodes)
+		if err == nil {
+			o.created[dc.Name] = true
+		}
+		return err
+	})
+	phase(StepGetDescriptor, func(dc DC) bool { return o.descriptors[dc.Name] != nil }, func(hc *antidote.HostClient, dc DC) error {
+		descriptor, err := hc.GetConnectionDescriptorContext(ctx)
+		if err == nil {
+			o.descriptors[dc.Name] = descriptor
+		}
+		return err
+	})
+	if len(errs) == 0 && ctx.Err() == nil {
+		descriptors := make([][]byte, len(o.topology.DCs))
+		for i, dc := range o.topology.DCs {
+			descriptors[i] = o.descriptors[dc.Name]
+		}
+		phase(StepConnect, func(dc DC) bool { return o.connected[dc.Name] }, func(hc *antidote.HostClient, dc DC) error {
+			err := hc.ConnectToDCsContext(ctx, descriptors)
+			if err == nil {
+				o.connected[dc.Name] = true
+			}
+			return err
+		})
+	}
+	if ctx.Err() != nil {
+		errs = append(errs, ctx.Err())
+	}
+	return report, errors.Join(errs...)
+}
+
+// Attempts a step until it succeeds, the attempts are exhausted or the context is done.
+// do is called with the number of the attempt, starting at 1.
+func (o *Orchestrator) perform(ctx context.Context, step Step, dc DC, do func(attempt int) error) (result StepResult) {
+	result = StepResult{Step: step, DC: dc.Name}
+	for result.Attempts < o.options.Attempts {
+		if result.Attempts > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(o.options.RetryDelay):
+			}
+		}
+		result.Attempts++
+		result.Err = do(result.Attempts)
+		if result.Err == nil {
+			return
+		}
+	}
+	return
+}
+
+func (o *Orchestrator) record(report *Report, result StepResult) {
+	report.Steps = append(report.Steps, result)
+	if o.options.OnStep != nil {
+		o.options.OnStep(result)
+	}
+}
@@ -0,0 +1,219 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/internal/antidotetest"
+	"google.golang.org/protobuf/proto"
+)
+
+// Stand-in for a node of an Antidote data center, answering the cluster management messages
+type fakeDC struct {
+	*antidotetest.Server
+	name string
+
+	mutex sync.Mutex
+	// number of requests by message code
+	requests map[antidote.MessageCode]int
+	// number of requests with the message code still to fail
+	failures  map[antidote.MessageCode]int
+	connected [][]byte
+	// if set, requests are answered once it is closed
+	blocked chan struct{}
+}
+
+func newFakeDC(t *testing.T, name string) *fakeDC {
+	dc := &fakeDC{name: name, requests: make(map[antidote.MessageCode]int), failures: make(map[antidote.MessageCode]int)}
+	dc.Server = antidotetest.NewServer(t, dc.respond)
+	return dc
+}
+
+func (dc *fakeDC) count(code antidote.MessageCode) int {
+	dc.mutex.Lock()
+	defer dc.mutex.Unlock()
+	return dc.requests[code]
+}
+
+func (dc *fakeDC) respond(_ int, code antidote.MessageCode, req proto.Message) (antidote.MessageCode, proto.Message) {
+	if dc.blocked != nil {
+		<-dc.blocked
+	}
+	dc.mutex.Lock()
+	defer dc.mutex.Unlock()
+	dc.requests[code]++
+	success := dc.failures[code] == 0
+	if !success {
+		dc.failures[code]--
+	}
+	errorcode := uint32(7)
+	switch code {
+	case antidote.MsgCreateDC:
+		return antidote.MsgCreateDCResp, &antidote.ApbCreateDCResp{Success: &success, Errorcode: &errorcode}
+	case antidote.MsgGetConnectionDescriptor:
+		return antidote.MsgGetConnectionDescriptorResp, &antidote.ApbGetConnectionDescriptorResp{Success: &success, Descriptor_: []byte(dc.name), Errorcode: &errorcode}
+	case antidote.MsgConnectToDCs:
+		if success {
+			dc.connected = req.(*antidote.ApbConnectToDCs).Descriptors
+		}
+		return antidote.MsgConnectToDCsResp, &antidote.ApbConnectToDCsResp{Success: &success, Errorcode: &errorcode}
+	}
+	return 0, nil
+}
+
+func TestBootstrap(t *testing.T) {
+	dc1, dc2 := newFakeDC(t, "dc1"), newFakeDC(t, "dc2")
+	dc2.failures[antidote.MsgGetConnectionDescriptor] = 1
+	var steps []StepResult
+	o, err := New(Topology{DCs: []DC{
+		{Name: "dc1", Hosts: []antidote.Host{dc1.Host()}, Nodes: []string{"antidote@dc1a", "antidote@dc1b"}},
+		{Name: "dc2", Hosts: []antidote.Host{dc2.Host()}},
+	}}, Options{RetryDelay: time.Millisecond, OnStep: func(r StepResult) { steps = append(steps, r) }})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := o.Bootstrap(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Steps) != 6 || len(steps) != 6 {
+		t.Fatalf("expected 6 steps, got %+v", report.Steps)
+	}
+	if r := report.Steps[1]; r.Step != StepCreateDC || !r.Skipped {
+		t.Fatalf("expected creation of dc2 without nodes to be skipped, got %+v", r)
+	}
+	if r := report.Steps[3]; r.Step != StepGetDescriptor || r.DC != "dc2" || r.Attempts != 2 {
+		t.Fatalf("expected descriptor of dc2 to be retried, got %+v", r)
+	}
+	for _, dc := range []*fakeDC{dc1, dc2} {
+		if len(dc.connected) != 2 || string(dc.connected[0]) != "dc1" || string(dc.connected[1]) != "dc2" {
+			t.Fatalf("%s connected to unexpected descriptors %q", dc.name, dc.connected)
+		}
+	}
+
+	// a second bootstrap does not repeat successful steps
+	if report, err = o.Bootstrap(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range report.Steps {
+		if !r.Skipped {
+			t.Fatalf("expected all steps to be skipped, got %+v", r)
+		}
+	}
+	if n := dc1.count(antidote.MsgCreateDC); n != 1 {
+		t.Fatalf("expected dc1 to be created once, got %d", n)
+	}
+}
+
+func TestBootstrapFailure(t *testing.T) {
+	dc1, dc2 := newFakeDC(t, "dc1"), newFakeDC(t, "dc2")
+	dc1.failures[antidote.MsgCreateDC] = 3
+	o, err := New(Topology{DCs: []DC{
+		{Name: "dc1", Hosts: []antidote.Host{dc1.Host()}, Nodes: []string{"antidote@dc1"}},
+		{Name: "dc2", Hosts: []antidote.Host{dc2.Host()}, Nodes: []string{"antidote@dc2"}},
+	}}, Options{Attempts: 3, RetryDelay: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = o.Bootstrap(context.Background())
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || stepErr.Step != StepCreateDC || stepErr.DC != "dc1" || stepErr.Attempts != 3 {
+		t.Fatalf("expected creation of dc1 to fail, got %v", err)
+	}
+	if dc1.count(antidote.MsgConnectToDCs) != 0 || dc2.count(antidote.MsgConnectToDCs) != 0 {
+		t.Fatal("expected data centers not to be connected after a failed step")
+	}
+
+	// resumes with the failed step
+	report, err := o.Bootstrap(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := report.Steps[1]; r.DC != "dc2" || !r.Skipped {
+		t.Fatalf("expected creation of dc2 to be skipped, got %+v", r)
+	}
+	if dc2.count(antidote.MsgCreateDC) != 1 || dc2.count(antidote.MsgConnectToDCs) != 1 {
+		t.Fatal("expected dc2 to be created and connected once")
+	}
+}
+
+func TestBootstrapTriesHosts(t *testing.T) {
+	first, second := newFakeDC(t, "dc1"), newFakeDC(t, "dc1")
+	first.failures[antidote.MsgGetConnectionDescriptor] = 1
+	o, err := New(Topology{DCs: []DC{
+		{Name: "dc1", Hosts: []antidote.Host{first.Host(), second.Host()}},
+	}}, Options{Attempts: 2, RetryDelay: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = o.Bootstrap(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if first.count(antidote.MsgGetConnectionDescriptor) != 1 || second.count(antidote.MsgGetConnectionDescriptor) != 1 {
+		t.Fatal("expected the second attempt to be sent to the second host")
+	}
+}
+
+func TestBootstrapSkipsUnreachableHosts(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().(*net.TCPAddr)
+	l.Close()
+	unreachable := antidote.Host{Name: addr.IP.String(), Port: addr.Port}
+	dc1 := newFakeDC(t, "dc1")
+	o, err := New(Topology{DCs: []DC{
+		{Name: "dc1", Hosts: []antidote.Host{unreachable, dc1.Host()}},
+	}}, Options{Attempts: 2, RetryDelay: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = o.Bootstrap(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if dc1.count(antidote.MsgGetConnectionDescriptor) != 1 || dc1.count(antidote.MsgConnectToDCs) != 1 {
+		t.Fatal("expected the second attempts to reach the second host")
+	}
+}
+
+func TestBootstrapCancel(t *testing.T) {
+	dc1 := newFakeDC(t, "dc1")
+	dc1.blocked = make(chan struct{})
+	defer close(dc1.blocked)
+	o, err := New(Topology{DCs: []DC{
+		{Name: "dc1", Hosts: []antidote.Host{dc1.Host()}, Nodes: []string{"antidote@dc1"}},
+	}}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err = o.Bootstrap(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("blocked request not interrupted, took %s", elapsed)
+	}
+}
+
+func TestNewValidatesTopology(t *testing.T) {
+	host := antidote.Host{Name: "127.0.0.1", Port: 8087}
+	for _, topology := range []Topology{
+		{DCs: []DC{{Hosts: []antidote.Host{host}}}},
+		{DCs: []DC{{Name: "dc1"}}},
+		{DCs: []DC{{Name: "dc1", Hosts: []antidote.Host{host}}, {Name: "dc1", Hosts: []antidote.Host{host}}}},
+	} {
+		if _, err := New(topology, Options{}); err == nil {
+			t.Fatalf("expected invalid topology %+v to be rejected", topology)
+		}
+	}
+}
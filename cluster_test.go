@@ -0,0 +1,61 @@
+package antidoteclient
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClusterErrors(t *testing.T) {
+	errorcode := uint32(4)
+	failure := false
+	server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		switch code {
+		case MsgCreateDC:
+			// no error code sent
+			return MsgCreateDCResp, &ApbCreateDCResp{Success: &failure}
+		case MsgGetConnectionDescriptor:
+			return MsgGetConnectionDescriptorResp, &ApbGetConnectionDescriptorResp{Success: &failure, Errorcode: &errorcode}
+		}
+		return 0, nil
+	})
+	other := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host(), other.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	err = client.CreateDcAt(server.host(), []string{"antidote@127.0.0.1"})
+	var cerr *ClusterError
+	if !errors.As(err, &cerr) || cerr.Op != OpCreateDC || cerr.Host != server.host() || cerr.Code() != 0 {
+		t.Fatalf("unexpected error %v", err)
+	}
+	_, err = client.GetConnectionDescriptorAt(server.host())
+	if !errors.As(err, &cerr) || cerr.Op != OpGetConnectionDescriptor || cerr.Code() != 4 {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !strings.Contains(err.Error(), "get_connection_descriptor on "+server.host().String()) {
+		t.Fatalf("unexpected message %q", err)
+	}
+	// the connection is closed by the server
+	err = client.ConnectToDCsAt(server.host(), [][]byte{[]byte("dc")})
+	if !errors.As(err, &cerr) || cerr.Op != OpConnectToDCs || cerr.Code() != 0 {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(other.received(MsgCreateDC)) != 0 {
+		t.Fatal("expected requests to be sent to the targeted host only")
+	}
+
+	err = client.CreateDcAt(Host{"10.0.0.1", 8087}, nil)
+	if !errors.Is(err, ErrUnknownHost) {
+		t.Fatalf("expected ErrUnknownHost, got %v", err)
+	}
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	if client.inFlight != 0 {
+		t.Fatalf("expected all connections to be returned, %d in use", client.inFlight)
+	}
+}
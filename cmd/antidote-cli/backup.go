@@ -0,0 +1,93 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/backup"
+)
+
+const backupUsage = `  export [-format json|proto] [-batch n] [-snapshot] [-index <key>] <bucket> [<type>:<key>...]
+  import [-batch n] [<file>]`
+
+// Parses the flags of export and import
+func backupFlags(name string, args []string, options *backup.Options, extra func(fs *flag.FlagSet)) ([]string, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.IntVar(&options.BatchSize, "batch", backup.DefaultBatchSize, "objects per transaction")
+	if extra != nil {
+		extra(fs)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, usageError("%s: %v", name, err)
+	}
+	return fs.Args(), nil
+}
+
+// Exports the objects given as arguments and listed in an index
+func runExport(t target, out io.Writer, args []string) (int, error) {
+	var options backup.Options
+	var format, index string
+	args, err := backupFlags("export", args, &options, func(fs *flag.FlagSet) {
+		fs.StringVar(&format, "format", "json", "json or proto")
+		fs.StringVar(&index, "index", "", "key of a set listing objects as type:key")
+		fs.BoolVar(&options.Snapshot, "snapshot", false, "read all batches from one snapshot")
+	})
+	if err != nil {
+		return 0, err
+	}
+	switch format {
+	case "json":
+		options.Format = backup.JSONLines
+	case "proto":
+		options.Format = backup.Protobuf
+	default:
+		return 0, usageError("unknown format %q", format)
+	}
+	if len(args) == 0 {
+		return 0, usageError("expected a bucket")
+	}
+	bucket := []byte(args[0])
+	var objects []*antidote.ApbBoundObject
+	for _, a := range args[1:] {
+		o, err := backup.ParseObject(bucket, a)
+		if err != nil {
+			return 0, usageError("%v", err)
+		}
+		objects = append(objects, o)
+	}
+	if index != "" {
+		listed, err := backup.ReadIndex(t.CreateStaticTransaction(), bucket, antidote.Key(index))
+		if err != nil {
+			return 0, err
+		}
+		objects = append(objects, listed...)
+	}
+	if len(objects) == 0 {
+		return 0, usageError("expected objects or an index")
+	}
+	return backup.Export(t, out, objects, options)
+}
+
+// Imports an archive from a file or the input
+func runImport(t target, stdin io.Reader, args []string) (int, error) {
+	var options backup.Options
+	args, err := backupFlags("import", args, &options, nil)
+	if err != nil {
+		return 0, err
+	}
+	switch len(args) {
+	case 0:
+		return backup.Import(t, stdin, options)
+	case 1:
+		f, err := os.Open(args[0])
+		if err != nil {
+			return 0, err
+		}
+		defer f.Close()
+		return backup.Import(t, f, options)
+	}
+	return 0, usageError("expected a single file")
+}
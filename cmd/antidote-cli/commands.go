@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/internal/operation"
+)
+
+// Usage of the statements reading and updating objects
+const objectUsage = `  counter get <bucket> <key>
+  counter inc <bucket> <key> [<n>]
+  set get <bucket> <key>
+  set add <bucket> <key> <elem>...
+  set remove <bucket> <key> <elem>...
+  reg get <bucket> <key>
+  reg put <bucket> <key> <value>
+  mvreg get <bucket> <key>
+  mvreg put <bucket> <key> <value>
+  flag get <bucket> <key>
+  flag put <bucket> <key> true|false
+  map get <bucket> <key>
+  map update <bucket> <key> put|mvput <path> <value>
+  map update <bucket> <key> inc <path> [<n>]
+  map update <bucket> <key> add|remove-elems <path> <elem>...
+  map update <bucket> <key> flag <path> true|false
+  map update <bucket> <key> remove <path> [<type>...]`
+
+// Usage of the data center management commands
+const dcUsage = `  dc create <node>...
+  dc descriptor
+  dc connect <descriptor>...`
+
+// Returned for statements that do not match the usage
+var errUsage = errors.New("invalid statement")
+
+func usageError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", errUsage, fmt.Sprintf(format, args...))
+}
+
+// Types of objects by their name in statements
+var statementTypes = map[string]string{
+	"counter": operation.Counter,
+	"set":     operation.Set,
+	"reg":     operation.Register,
+	"mvreg":   operation.MVRegister,
+	"flag":    operation.Flag,
+	"map":     operation.Map,
+}
+
+// Executes a statement reading or updating an object in the context of the transaction.
+// Returns the value read, or nil for updates.
+func runStatement(tx antidote.Transaction, args []string) (result interface{}, err error) {
+	o, err := parseStatement(args)
+	if err != nil {
+		return nil, err
+	}
+	result, err = operation.Execute(tx, o)
+	var invalid *operation.InvalidError
+	if errors.As(err, &invalid) {
+		return nil, usageError("%v", err)
+	}
+	return result, err
+}
+
+// Parses a statement into the operation it executes
+func parseStatement(args []string) (o operation.Operation, err error) {
+	if len(args) < 4 {
+		return o, usageError("expected <type> <operation> <bucket> <key>")
+	}
+	crdt, ok := statementTypes[args[0]]
+	if !ok {
+		return o, usageError("unknown type %q", args[0])
+	}
+	o = operation.Operation{Type: crdt, Op: args[1], Bucket: args[2], Key: args[3]}
+	params := args[4:]
+	switch o.Op {
+	case "inc":
+		o.Amount, err = parseAmount(params)
+	case "add", "remove":
+		o.Elems = params
+	case "put":
+		o.Value = single(params)
+	case "update":
+		var u operation.MapOperation
+		u, err = parseMapOperation(params)
+		o.Updates = []operation.MapOperation{u}
+	}
+	return o, err
+}
+
+// Parses the parameters of a map update statement into the update of a nested map entry
+func parseMapOperation(params []string) (u operation.MapOperation, err error) {
+	if len(params) < 2 {
+		return u, usageError("expected <operation> <path>")
+	}
+	u = operation.MapOperation{Op: params[0], Path: params[1]}
+	values := params[2:]
+	switch u.Op {
+	case "put", "mvput", "flag":
+		u.Value = single(values)
+	case "inc":
+		u.Amount, err = parseAmount(values)
+	case "add", "remove-elems":
+		u.Elems = values
+	case "remove":
+		u.Types = values
+	}
+	return u, err
+}
+
+// Returns the increment given as first parameter, nil if there is none
+func parseAmount(params []string) (*int64, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	inc, err := strconv.ParseInt(params[0], 10, 64)
+	if err != nil {
+		return nil, usageError("invalid increment %q", params[0])
+	}
+	return &inc, nil
+}
+
+// Returns the only parameter, nil if there is none or several
+func single(params []string) interface{} {
+	if len(params) != 1 {
+		return nil
+	}
+	return params[0]
+}
+
+// The data center management operations of a client or a single host
+type dcManager interface {
+	CreateDc(nodeNames []string) error
+	GetConnectionDescriptor() ([]byte, error)
+	ConnectToDCs(descriptors [][]byte) error
+}
+
+// Executes a data center management command. Descriptors are given and returned in base64.
+func runDC(m dcManager, args []string) (result interface{}, err error) {
+	if len(args) == 0 {
+		return nil, usageError("expected create, descriptor or connect")
+	}
+	switch args[0] {
+	case "create":
+		if len(args) < 2 {
+			return nil, usageError("expected node names")
+		}
+		return nil, m.CreateDc(args[1:])
+	case "descriptor":
+		descriptor, err := m.GetConnectionDescriptor()
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString(descriptor), nil
+	case "connect":
+		if len(args) < 2 {
+			return nil, usageError("expected descriptors")
+		}
+		descriptors := make([][]byte, len(args)-1)
+		for i, d := range args[1:] {
+			if descriptors[i], err = base64.StdEncoding.DecodeString(d); err != nil {
+				return nil, usageError("invalid descriptor %q", d)
+			}
+		}
+		return nil, m.ConnectToDCs(descriptors)
+	}
+	return nil, usageError("unknown dc command %q", args[0])
+}
+
+// Splits a statement into arguments separated by white space.
+// Arguments can be quoted with single or double quotes; a semicolon outside of quotes ends the statement.
+// Returns the statements of the line.
+func splitStatements(line string) (statements [][]string, err error) {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	var quote rune
+	endArg := func() {
+		if inArg {
+			args = append(args, arg.String())
+			arg.Reset()
+			inArg = false
+		}
+	}
+	for _, r := range line {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ';':
+			endArg()
+			if len(args) > 0 {
+				statements = append(statements, args)
+			}
+			args = nil
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			endArg()
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, usageError("unterminated quote")
+	}
+	endArg()
+	if len(args) > 0 {
+		statements = append(statements, args)
+	}
+	return
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"google.golang.org/protobuf/proto"
+)
+
+// Records updates and answers reads with fixed values
+type fakeTx struct {
+	updates []*antidote.ApbUpdateOp
+}
+
+func (tx *fakeTx) Update(updates ...*antidote.ApbUpdateOp) error {
+	tx.updates = append(tx.updates, updates...)
+	return nil
+}
+
+func (tx *fakeTx) Read(objects ...*antidote.ApbBoundObject) (*antidote.ApbReadObjectsResp, error) {
+	success := true
+	value := int32(3)
+	resp := &antidote.ApbReadObjectsResp{Success: &success}
+	for _, o := range objects {
+		switch o.GetType() {
+		case antidote.CRDTType_COUNTER:
+			resp.Objects = append(resp.Objects, &antidote.ApbReadObjectResp{Counter: &antidote.ApbGetCounterResp{Value: &value}})
+		case antidote.CRDTType_ORSET:
+			resp.Objects = append(resp.Objects, &antidote.ApbReadObjectResp{Set: &antidote.ApbGetSetResp{Value: [][]byte{[]byte("a"), []byte("b")}}})
+		case antidote.CRDTType_RRMAP:
+			regType := antidote.CRDTType_LWWREG
+			resp.Objects = append(resp.Objects, &antidote.ApbReadObjectResp{Map: &antidote.ApbGetMapResp{Entries: []*antidote.ApbMapEntry{{
+				Key:   &antidote.ApbMapKey{Key: []byte("name"), Type: &regType},
+				Value: &antidote.ApbReadObjectResp{Reg: &antidote.ApbGetRegResp{Value: []byte("x")}},
+			}}}})
+		}
+	}
+	return resp, nil
+}
+
+func TestRunStatement(t *testing.T) {
+	tx := &fakeTx{}
+	for _, s := range []string{"counter inc b k 5", "set add b s x y", "map update b m put name x", "flag put b f true"} {
+		statements, err := splitStatements(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = runStatement(tx, statements[0]); err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+	}
+	mapUpdate, _ := antidote.NewMapUpdateBuilder(antidote.Key("m")).Put("name", []byte("x")).Build()
+	expected := []*antidote.ApbUpdateOp{
+		antidote.CounterInc(antidote.Key("k"), 5).ConvertToToplevel([]byte("b")),
+		antidote.SetAdd(antidote.Key("s"), []byte("x"), []byte("y")).ConvertToToplevel([]byte("b")),
+		mapUpdate.ConvertToToplevel([]byte("b")),
+		antidote.FlagPut(antidote.Key("f"), true).ConvertToToplevel([]byte("b")),
+	}
+	if len(tx.updates) != len(expected) {
+		t.Fatalf("expected %d updates, got %d", len(expected), len(tx.updates))
+	}
+	for i := range expected {
+		if !proto.Equal(tx.updates[i], expected[i]) {
+			t.Fatalf("unexpected update %d: %v", i, tx.updates[i])
+		}
+	}
+
+	for statement, expected := range map[string]interface{}{
+		"counter get b k": int32(3),
+		"set get b s":     []string{"a", "b"},
+		"map get b m":     map[string]interface{}{"name": "x"},
+	} {
+		statements, _ := splitStatements(statement)
+		result, err := runStatement(tx, statements[0])
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Fatalf("%s: unexpected result %#v (%v)", statement, result, err)
+		}
+	}
+
+	for _, invalid := range []string{"counter get b", "counter inc b k x", "register get b k", "map update b m remove x nope"} {
+		statements, _ := splitStatements(invalid)
+		if _, err := runStatement(tx, statements[0]); !errors.Is(err, errUsage) {
+			t.Fatalf("%s: expected usage error, got %v", invalid, err)
+		}
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	statements, err := splitStatements(`reg put b k "a value; with semicolon" ; counter inc b 'my key'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{{"reg", "put", "b", "k", "a value; with semicolon"}, {"counter", "inc", "b", "my key"}}
+	if !reflect.DeepEqual(statements, expected) {
+		t.Fatalf("unexpected statements %q", statements)
+	}
+	if _, err = splitStatements(`reg put b k "open`); err == nil {
+		t.Fatal("expected error for unterminated quote")
+	}
+}
+
+type fakeDCManager struct {
+	nodes       []string
+	descriptors [][]byte
+}
+
+func (m *fakeDCManager) CreateDc(nodeNames []string) error {
+	m.nodes = nodeNames
+	return nil
+}
+
+func (m *fakeDCManager) GetConnectionDescriptor() ([]byte, error) {
+	return []byte("descriptor"), nil
+}
+
+func (m *fakeDCManager) ConnectToDCs(descriptors [][]byte) error {
+	m.descriptors = descriptors
+	return nil
+}
+
+func TestRunDC(t *testing.T) {
+	m := &fakeDCManager{}
+	if _, err := runDC(m, []string{"create", "antidote@a", "antidote@b"}); err != nil || len(m.nodes) != 2 {
+		t.Fatalf("unexpected create: %v, %v", m.nodes, err)
+	}
+	descriptor, err := runDC(m, []string{"descriptor"})
+	if err != nil || descriptor != base64.StdEncoding.EncodeToString([]byte("descriptor")) {
+		t.Fatalf("unexpected descriptor %v (%v)", descriptor, err)
+	}
+	if _, err = runDC(m, []string{"connect", descriptor.(string)}); err != nil || !bytes.Equal(m.descriptors[0], []byte("descriptor")) {
+		t.Fatalf("unexpected connect: %q, %v", m.descriptors, err)
+	}
+}
@@ -0,0 +1,294 @@
+// Command antidote-cli reads and updates objects in Antidote and manages data centers from the shell.
+//
+//	antidote-cli counter inc bucket key 5
+//	antidote-cli -json map get bucket key
+//	antidote-cli tx 'counter inc bucket a 1; reg put bucket b "some value"; counter get bucket a'
+//	antidote-cli -on 10.0.0.1:8087 dc descriptor
+//	antidote-cli shell
+//	antidote-cli export -index index bucket > bucket.jsonl
+//	antidote-cli import bucket.jsonl
+//
+// Statements outside of tx are executed as static transactions.
+// The statements of tx are executed in a single interactive transaction, read from standard input if not given as arguments.
+// The shell keeps the client open and executes statements line by line, in a transaction between begin and commit or abort.
+// Export writes objects as archive to standard output, import restores them.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+)
+
+// Collects the values of a repeatable host flag
+type hostsFlag []antidote.Host
+
+func (h *hostsFlag) String() string {
+	hosts := make([]string, len(*h))
+	for i, host := range *h {
+		hosts[i] = host.String()
+	}
+	return strings.Join(hosts, ",")
+}
+
+func (h *hostsFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		host, err := antidote.ParseHost(v)
+		if err != nil {
+			return err
+		}
+		*h = append(*h, host)
+	}
+	return nil
+}
+
+// Settings of a run of the tool
+type cli struct {
+	hosts hostsFlag
+	on    string
+	json  bool
+	out   io.Writer
+}
+
+func main() {
+	c := &cli{out: os.Stdout}
+	if err := c.run(os.Args[1:], os.Stdin); err != nil {
+		c.printError(err)
+		if errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+func (c *cli) flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("antidote-cli", flag.ContinueOnError)
+	fs.Var(&c.hosts, "host", "Antidote host `name:port`, repeatable or comma-separated (default 127.0.0.1:8087)")
+	fs.StringVar(&c.on, "on", "", "send all requests to the given `name:port`, which is added to the hosts")
+	fs.BoolVar(&c.json, "json", false, "print results as JSON")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: antidote-cli [flags] <statement>\n       antidote-cli [flags] tx [<statement>; ...]\n       antidote-cli [flags] dc ...\n       antidote-cli [flags] shell\n       antidote-cli [flags] export|import ...\n\nStatements:\n%s\n\nData centers:\n%s\n\nBackups:\n%s\n\nFlags:\n", objectUsage, dcUsage, backupUsage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// The operations of a client or of the handle of a single host
+type target interface {
+	dcManager
+	StartTransaction() (*antidote.InteractiveTransaction, error)
+	CreateStaticTransaction() *antidote.StaticTransaction
+}
+
+// Connects to the hosts and selects the target of the requests
+func (c *cli) connect() (*antidote.Client, target, error) {
+	var on antidote.Host
+	if c.on != "" {
+		var err error
+		if on, err = antidote.ParseHost(c.on); err != nil {
+			return nil, nil, usageError("invalid host %q", c.on)
+		}
+		if !containsHost(c.hosts, on) {
+			c.hosts = append(c.hosts, on)
+		}
+	}
+	if len(c.hosts) == 0 {
+		c.hosts = hostsFlag{{Name: "127.0.0.1", Port: 8087}}
+	}
+	client, err := antidote.NewClient(c.hosts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.on == "" {
+		return client, client, nil
+	}
+	hc, err := client.OnHost(on)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return client, hc, nil
+}
+
+func containsHost(hosts []antidote.Host, host antidote.Host) bool {
+	for _, h := range hosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *cli) run(args []string, stdin io.Reader) error {
+	fs := c.flags()
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return usageError("%v", err)
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		fs.Usage()
+		return usageError("expected a statement")
+	}
+	client, t, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	switch args[0] {
+	case "shell":
+		return c.runShell(t)
+	case "export":
+		_, err := runExport(t, c.out, args[1:])
+		return err
+	case "import":
+		n, err := runImport(t, stdin, args[1:])
+		if err != nil {
+			return err
+		}
+		c.printResult(n)
+		return nil
+	case "dc":
+		result, err := runDC(t, args[1:])
+		if err != nil {
+			return err
+		}
+		c.printResult(result)
+		return nil
+	case "tx":
+		statements, err := c.txStatements(args[1:], stdin)
+		if err != nil {
+			return err
+		}
+		results, err := runTransaction(t, statements)
+		if err != nil {
+			return err
+		}
+		c.printResults(results)
+		return nil
+	}
+	result, err := runStatement(t.CreateStaticTransaction(), args)
+	if err != nil {
+		return err
+	}
+	c.printResult(result)
+	return nil
+}
+
+// Returns the statements of a transaction, given as arguments or read from the input.
+// A single argument is split like a line of the input;
+// otherwise, the arguments are taken as they are and statements are separated by arguments ending with a semicolon.
+func (c *cli) txStatements(args []string, stdin io.Reader) ([][]string, error) {
+	if len(args) == 1 {
+		return splitStatements(args[0])
+	}
+	if len(args) > 1 {
+		var statements [][]string
+		var statement []string
+		for _, a := range args {
+			if strings.HasSuffix(a, ";") {
+				if a = strings.TrimSuffix(a, ";"); a != "" {
+					statement = append(statement, a)
+				}
+				if len(statement) > 0 {
+					statements = append(statements, statement)
+				}
+				statement = nil
+			} else {
+				statement = append(statement, a)
+			}
+		}
+		if len(statement) > 0 {
+			statements = append(statements, statement)
+		}
+		return statements, nil
+	}
+	var statements [][]string
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		s, err := splitStatements(scanner.Text())
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, s...)
+	}
+	return statements, scanner.Err()
+}
+
+// Executes the statements in an interactive transaction and commits it.
+// Aborts the transaction if a statement fails.
+func runTransaction(t target, statements [][]string) ([]interface{}, error) {
+	if len(statements) == 0 {
+		return nil, usageError("expected statements")
+	}
+	tx, err := t.StartTransaction()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]interface{}, len(statements))
+	for i, s := range statements {
+		if results[i], err = runStatement(tx, s); err != nil {
+			tx.Abort()
+			return nil, fmt.Errorf("statement %d (%s): %w", i+1, strings.Join(s, " "), err)
+		}
+	}
+	return results, tx.Commit()
+}
+
+func (c *cli) printResult(result interface{}) {
+	if c.json {
+		c.printJSON(map[string]interface{}{"result": result})
+		return
+	}
+	c.printText(result)
+}
+
+func (c *cli) printResults(results []interface{}) {
+	if c.json {
+		c.printJSON(map[string]interface{}{"results": results})
+		return
+	}
+	for _, r := range results {
+		if r != nil {
+			c.printText(r)
+		}
+	}
+}
+
+func (c *cli) printText(result interface{}) {
+	switch r := result.(type) {
+	case nil:
+	case []string:
+		for _, s := range r {
+			fmt.Fprintln(c.out, s)
+		}
+	case map[string]interface{}:
+		b, _ := json.MarshalIndent(r, "", "  ")
+		fmt.Fprintln(c.out, string(b))
+	default:
+		fmt.Fprintln(c.out, r)
+	}
+}
+
+func (c *cli) printJSON(v interface{}) {
+	b, _ := json.Marshal(v)
+	fmt.Fprintln(c.out, string(b))
+}
+
+func (c *cli) printError(err error) {
+	if c.json {
+		c.printJSON(map[string]interface{}{"error": err.Error()})
+		return
+	}
+	fmt.Fprintln(os.Stderr, "antidote-cli:", err)
+}
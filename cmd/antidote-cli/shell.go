@@ -0,0 +1,287 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/peterh/liner"
+)
+
+// Usage of the commands of the interactive shell
+const shellUsage = `  begin                  start an interactive transaction
+  commit                 commit the transaction
+  abort                  abort the transaction
+  help                   show this help
+  exit                   leave the shell, aborting an open transaction`
+
+// Name of the history file in the home directory
+const historyFile = ".antidote_history"
+
+// Operations of the statements on each CRDT type, used for completion
+var statementOperations = map[string][]string{
+	"counter": {"get", "inc"},
+	"set":     {"get", "add", "remove"},
+	"reg":     {"get", "put"},
+	"mvreg":   {"get", "put"},
+	"flag":    {"get", "put"},
+	"map":     {"get", "update"},
+}
+
+var (
+	shellCommands = []string{"begin", "commit", "abort", "help", "exit", "quit", "dc"}
+	dcCommands    = []string{"create", "descriptor", "connect"}
+	mapOperations = []string{"put", "mvput", "inc", "add", "remove-elems", "flag", "remove"}
+	boolValues    = []string{"true", "false"}
+)
+
+// An interactive transaction as used by the shell
+type shellTx interface {
+	antidote.Transaction
+	Commit() error
+	Abort() error
+}
+
+// State of an interactive session: statements run in the open transaction if there is one,
+// in a static transaction otherwise.
+type shell struct {
+	cli    *cli
+	dc     dcManager
+	static func() antidote.Transaction
+	begin  func() (shellTx, error)
+	tx     shellTx
+}
+
+func newShell(c *cli, t target) *shell {
+	return &shell{
+		cli:    c,
+		dc:     t,
+		static: func() antidote.Transaction { return t.CreateStaticTransaction() },
+		begin: func() (shellTx, error) {
+			tx, err := t.StartTransaction()
+			if err != nil {
+				return nil, err
+			}
+			return tx, nil
+		},
+	}
+}
+
+// Reads and executes lines until exit or the end of the input.
+// The history is loaded from and saved to ~/.antidote_history.
+func (c *cli) runShell(t target) error {
+	s := newShell(c, t)
+	line := liner.NewLiner()
+	defer line.Close()
+	line.SetCtrlCAborts(true)
+	line.SetWordCompleter(s.complete)
+
+	history := historyPath()
+	if history != "" {
+		if f, err := os.Open(history); err == nil {
+			line.ReadHistory(f)
+			f.Close()
+		}
+		defer func() {
+			if f, err := os.Create(history); err == nil {
+				line.WriteHistory(f)
+				f.Close()
+			}
+		}()
+	}
+	defer s.close()
+
+	for {
+		input, err := line.Prompt(s.prompt())
+		if err == liner.ErrPromptAborted {
+			continue
+		}
+		if err == io.EOF {
+			fmt.Fprintln(c.out)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(input) == "" {
+			continue
+		}
+		line.AppendHistory(input)
+		quit, err := s.execute(input)
+		if err != nil {
+			c.printError(err)
+		}
+		if quit {
+			return nil
+		}
+	}
+}
+
+func historyPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, historyFile)
+}
+
+func (s *shell) prompt() string {
+	if s.tx != nil {
+		return "antidote tx> "
+	}
+	return "antidote> "
+}
+
+// Executes a line of input. Returns true if the shell should exit.
+func (s *shell) execute(line string) (quit bool, err error) {
+	statements, err := splitStatements(line)
+	if err != nil {
+		return false, err
+	}
+	for _, args := range statements {
+		if quit, err = s.executeStatement(args); quit || err != nil {
+			return
+		}
+	}
+	return false, nil
+}
+
+func (s *shell) executeStatement(args []string) (quit bool, err error) {
+	switch args[0] {
+	case "exit", "quit":
+		return true, nil
+	case "help":
+		fmt.Fprintf(s.cli.out, "Commands:\n%s\n\nStatements:\n%s\n\nData centers:\n%s\n", shellUsage, objectUsage, dcUsage)
+		return false, nil
+	case "begin":
+		if s.tx != nil {
+			return false, errors.New("transaction already started")
+		}
+		s.tx, err = s.begin()
+		return false, err
+	case "commit", "abort":
+		if s.tx == nil {
+			return false, errors.New("no transaction started")
+		}
+		tx := s.tx
+		s.tx = nil
+		if args[0] == "commit" {
+			return false, tx.Commit()
+		}
+		return false, tx.Abort()
+	case "dc":
+		result, err := runDC(s.dc, args[1:])
+		if err != nil {
+			return false, err
+		}
+		s.print(result)
+		return false, nil
+	}
+	var tx antidote.Transaction = s.tx
+	if s.tx == nil {
+		tx = s.static()
+	}
+	result, err := runStatement(tx, args)
+	if err != nil {
+		if s.tx != nil && errors.Is(err, antidote.ErrTxDone) {
+			s.tx = nil
+		}
+		return false, err
+	}
+	s.print(result)
+	return false, nil
+}
+
+// Aborts the open transaction, if any
+func (s *shell) close() {
+	if s.tx != nil {
+		s.tx.Abort()
+		s.tx = nil
+		fmt.Fprintln(s.cli.out, "transaction aborted")
+	}
+}
+
+// Prints a result, nested maps as an indented tree
+func (s *shell) print(result interface{}) {
+	if m, ok := result.(map[string]interface{}); ok && !s.cli.json {
+		printTree(s.cli.out, m, "")
+		return
+	}
+	s.cli.printResult(result)
+}
+
+func printTree(w io.Writer, m map[string]interface{}, indent string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			fmt.Fprintf(w, "%s%s:\n", indent, k)
+			printTree(w, v, indent+"  ")
+		case []string:
+			fmt.Fprintf(w, "%s%s: [%s]\n", indent, k, strings.Join(v, ", "))
+		default:
+			fmt.Fprintf(w, "%s%s: %v\n", indent, k, v)
+		}
+	}
+}
+
+// Completes the word before the cursor with commands, CRDT types, operations and values,
+// depending on its position in the statement.
+func (s *shell) complete(line string, pos int) (head string, completions []string, tail string) {
+	head, tail = line[:pos], line[pos:]
+	statement := head
+	if i := strings.LastIndex(statement, ";"); i >= 0 {
+		statement = statement[i+1:]
+	}
+	prefix := ""
+	if i := strings.LastIndexAny(statement, " \t"); i < len(statement)-1 {
+		prefix = statement[i+1:]
+	}
+	head = head[:len(head)-len(prefix)]
+
+	var candidates []string
+	words := strings.Fields(statement[:len(statement)-len(prefix)])
+	switch {
+	case len(words) == 0:
+		candidates = append(append([]string{}, shellCommands...), crdtTypes()...)
+	case len(words) == 1 && words[0] == "dc":
+		candidates = dcCommands
+	case len(words) == 1:
+		candidates = statementOperations[words[0]]
+	case len(words) == 4 && words[0] == "flag" && words[1] == "put":
+		candidates = boolValues
+	case len(words) == 4 && words[0] == "map" && words[1] == "update":
+		candidates = mapOperations
+	case len(words) == 6 && words[0] == "map" && words[1] == "update" && words[4] == "flag":
+		candidates = boolValues
+	case len(words) >= 6 && words[0] == "map" && words[1] == "update" && words[4] == "remove":
+		for t := range antidote.CRDTType_value {
+			candidates = append(candidates, strings.ToLower(t))
+		}
+		sort.Strings(candidates)
+	}
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			completions = append(completions, c)
+		}
+	}
+	return
+}
+
+func crdtTypes() []string {
+	types := make([]string, 0, len(statementOperations))
+	for t := range statementOperations {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
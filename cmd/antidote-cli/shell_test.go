@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+)
+
+// Records updates like fakeTx and whether it was committed or aborted
+type fakeInteractiveTx struct {
+	fakeTx
+	committed, aborted bool
+}
+
+func (tx *fakeInteractiveTx) Commit() error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeInteractiveTx) Abort() error {
+	tx.aborted = true
+	return nil
+}
+
+func newFakeShell() (s *shell, static *fakeTx, txs *[]*fakeInteractiveTx, out *bytes.Buffer) {
+	out = &bytes.Buffer{}
+	static = &fakeTx{}
+	txs = &[]*fakeInteractiveTx{}
+	s = &shell{
+		cli:    &cli{out: out},
+		dc:     &fakeDCManager{},
+		static: func() antidote.Transaction { return static },
+		begin: func() (shellTx, error) {
+			tx := &fakeInteractiveTx{}
+			*txs = append(*txs, tx)
+			return tx, nil
+		},
+	}
+	return
+}
+
+func TestShellTransactions(t *testing.T) {
+	s, static, txs, _ := newFakeShell()
+	if _, err := s.execute("counter inc b k"); err != nil || len(static.updates) != 1 {
+		t.Fatalf("expected static update, got %v (%v)", static.updates, err)
+	}
+	if _, err := s.execute("begin; counter inc b k; set add b s x"); err != nil {
+		t.Fatal(err)
+	}
+	if s.prompt() != "antidote tx> " {
+		t.Fatalf("unexpected prompt %q", s.prompt())
+	}
+	if _, err := s.execute("begin"); err == nil {
+		t.Fatal("expected error for nested begin")
+	}
+	if _, err := s.execute("commit"); err != nil {
+		t.Fatal(err)
+	}
+	tx := (*txs)[0]
+	if !tx.committed || len(tx.updates) != 2 || len(static.updates) != 1 {
+		t.Fatalf("unexpected transaction %+v", tx)
+	}
+	if _, err := s.execute("abort"); err == nil {
+		t.Fatal("expected error for abort without transaction")
+	}
+
+	s.execute("begin")
+	s.close()
+	if !(*txs)[1].aborted || s.tx != nil {
+		t.Fatal("expected open transaction to be aborted on close")
+	}
+	if quit, err := s.execute("exit"); !quit || err != nil {
+		t.Fatalf("expected exit, got %v, %v", quit, err)
+	}
+}
+
+func TestShellPrintsTree(t *testing.T) {
+	s, _, _, out := newFakeShell()
+	s.print(map[string]interface{}{
+		"name":    "x",
+		"tags":    []string{"a", "b"},
+		"address": map[string]interface{}{"city": "Berlin", "visits": int32(2)},
+	})
+	expected := "address:\n  city: Berlin\n  visits: 2\nname: x\ntags: [a, b]\n"
+	if out.String() != expected {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestShellComplete(t *testing.T) {
+	s, _, _, _ := newFakeShell()
+	for _, c := range []struct {
+		line        string
+		head        string
+		completions []string
+	}{
+		{"co", "", []string{"commit", "counter"}},
+		{"counter ", "counter ", []string{"get", "inc"}},
+		{"begin; set a", "begin; set ", []string{"add"}},
+		{"dc d", "dc ", []string{"descriptor"}},
+		{"map update b k f", "map update b k ", []string{"flag"}},
+		{"map update b k flag p t", "map update b k flag p ", []string{"true"}},
+		{"map update b k remove p lww", "map update b k remove p ", []string{"lwwreg"}},
+		{"counter get b ", "counter get b ", nil},
+	} {
+		head, completions, tail := s.complete(c.line, len(c.line))
+		if head != c.head || !reflect.DeepEqual(completions, c.completions) || tail != "" {
+			t.Fatalf("%q: unexpected completion %q, %q", c.line, head, completions)
+		}
+	}
+}
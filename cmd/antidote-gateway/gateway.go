@@ -0,0 +1,197 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/internal/operation"
+)
+
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// Maximum size of request bodies
+const maxBodySize = 1 << 20
+
+// Types of objects by their name in paths
+var pathTypes = map[string]string{
+	"counters":    operation.Counter,
+	"sets":        operation.Set,
+	"registers":   operation.Register,
+	"mvregisters": operation.MVRegister,
+	"flags":       operation.Flag,
+	"maps":        operation.Map,
+}
+
+// Body of transaction requests
+type transactionRequest struct {
+	Operations []operation.Operation `json:"operations"`
+}
+
+// Returned for invalid requests, answered with status 400
+type requestError struct {
+	msg string
+}
+
+func (err *requestError) Error() string {
+	return err.msg
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &requestError{fmt.Sprintf(format, args...)}
+}
+
+// An interactive transaction as used by the gateway
+type interactiveTx interface {
+	antidote.Transaction
+	Commit() error
+	Abort() error
+}
+
+// Provides the transactions of the gateway
+type backend interface {
+	Static() antidote.Transaction
+	Begin() (interactiveTx, error)
+}
+
+// Backend of a client connected to Antidote
+type clientBackend struct {
+	client *antidote.Client
+}
+
+func (b clientBackend) Static() antidote.Transaction {
+	return b.client.CreateStaticTransaction()
+}
+
+func (b clientBackend) Begin() (interactiveTx, error) {
+	tx, err := b.client.StartTransaction()
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Translates REST requests into reads and updates of Antidote objects.
+//
+//	GET  /buckets/{bucket}/{type}/{key}       reads an object
+//	PUT  /buckets/{bucket}/{type}/{key}       puts the value of a register or flag
+//	POST /buckets/{bucket}/{type}/{key}/{op}  updates an object, e.g. counters/{key}/inc
+//	POST /transactions                        executes operations in an interactive transaction
+//	GET  /openapi.yaml                        the OpenAPI specification
+type gateway struct {
+	backend backend
+	mux     *http.ServeMux
+}
+
+func newGateway(b backend) *gateway {
+	g := &gateway{backend: b, mux: http.NewServeMux()}
+	g.mux.HandleFunc("GET /buckets/{bucket}/{type}/{key}", g.handleObject("get"))
+	g.mux.HandleFunc("PUT /buckets/{bucket}/{type}/{key}", g.handleObject("put"))
+	g.mux.HandleFunc("POST /buckets/{bucket}/{type}/{key}/{op}", g.handleObject(""))
+	g.mux.HandleFunc("POST /transactions", g.handleTransaction)
+	g.mux.HandleFunc("GET /openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(openAPISpec)
+	})
+	return g
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// Handles the requests on a single object, executed as a static transaction.
+// The operation is taken from the path if op is empty.
+func (g *gateway) handleObject(op string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var o operation.Operation
+		if r.Method != http.MethodGet {
+			if err := decodeBody(r, &o); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+		t, ok := pathTypes[r.PathValue("type")]
+		if !ok {
+			writeError(w, badRequest("unknown type %q", r.PathValue("type")))
+			return
+		}
+		o.Bucket, o.Type, o.Key, o.Op = r.PathValue("bucket"), t, r.PathValue("key"), op
+		if op == "" {
+			o.Op = r.PathValue("op")
+		}
+		result, err := operation.Execute(g.backend.Static(), o)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if o.Op != "get" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"value": result})
+	}
+}
+
+// Executes the operations of the request in an interactive transaction and returns their results.
+// The transaction is aborted if an operation fails.
+func (g *gateway) handleTransaction(w http.ResponseWriter, r *http.Request) {
+	var req transactionRequest
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(req.Operations) == 0 {
+		writeError(w, badRequest("expected operations"))
+		return
+	}
+	tx, err := g.backend.Begin()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	results := make([]interface{}, len(req.Operations))
+	for i, o := range req.Operations {
+		if results[i], err = operation.Execute(tx, o); err != nil {
+			tx.Abort()
+			writeError(w, fmt.Errorf("operation %d: %w", i, err))
+			return
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"results": results})
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(io.LimitReader(r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil && err != io.EOF {
+		return badRequest("invalid body: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// Answers invalid requests with status 400 and failures of Antidote with 502
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	var rerr *requestError
+	var invalid *operation.InvalidError
+	if errors.As(err, &rerr) || errors.As(err, &invalid) {
+		status = http.StatusBadRequest
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"google.golang.org/protobuf/proto"
+)
+
+// In-memory stand-in for Antidote, applying updates to the values of objects.
+// Interactive transactions work on a copy of the objects, installed on commit.
+type memStore struct {
+	mutex   sync.Mutex
+	objects map[string]*antidote.ApbReadObjectResp
+}
+
+func newMemStore() *memStore {
+	return &memStore{objects: make(map[string]*antidote.ApbReadObjectResp)}
+}
+
+func (s *memStore) Static() antidote.Transaction {
+	return &memTx{store: s}
+}
+
+func (s *memStore) Begin() (interactiveTx, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return &memTx{store: s, objects: cloneObjects(s.objects)}, nil
+}
+
+func cloneObjects(objects map[string]*antidote.ApbReadObjectResp) map[string]*antidote.ApbReadObjectResp {
+	res := make(map[string]*antidote.ApbReadObjectResp, len(objects))
+	for k, v := range objects {
+		res[k] = proto.Clone(v).(*antidote.ApbReadObjectResp)
+	}
+	return res
+}
+
+// A static transaction if objects is nil
+type memTx struct {
+	store   *memStore
+	objects map[string]*antidote.ApbReadObjectResp
+	done    bool
+}
+
+func (tx *memTx) do(f func(objects map[string]*antidote.ApbReadObjectResp)) {
+	if tx.objects != nil {
+		f(tx.objects)
+		return
+	}
+	tx.store.mutex.Lock()
+	defer tx.store.mutex.Unlock()
+	f(tx.store.objects)
+}
+
+func objectID(o *antidote.ApbBoundObject) string {
+	return string(o.Bucket) + "/" + o.GetType().String() + "/" + string(o.Key)
+}
+
+func (tx *memTx) Read(objects ...*antidote.ApbBoundObject) (*antidote.ApbReadObjectsResp, error) {
+	success := true
+	resp := &antidote.ApbReadObjectsResp{Success: &success}
+	tx.do(func(state map[string]*antidote.ApbReadObjectResp) {
+		for _, o := range objects {
+			obj, ok := state[objectID(o)]
+			if !ok {
+				obj = newObject(o.GetType())
+			}
+			resp.Objects = append(resp.Objects, proto.Clone(obj).(*antidote.ApbReadObjectResp))
+		}
+	})
+	return resp, nil
+}
+
+func (tx *memTx) Update(updates ...*antidote.ApbUpdateOp) error {
+	tx.do(func(state map[string]*antidote.ApbReadObjectResp) {
+		for _, u := range updates {
+			id := objectID(u.Boundobject)
+			if state[id] == nil {
+				state[id] = newObject(u.Boundobject.GetType())
+			}
+			apply(state[id], u.Operation)
+		}
+	})
+	return nil
+}
+
+func (tx *memTx) Commit() error {
+	tx.store.mutex.Lock()
+	defer tx.store.mutex.Unlock()
+	tx.store.objects, tx.done = tx.objects, true
+	return nil
+}
+
+func (tx *memTx) Abort() error {
+	tx.done = true
+	return nil
+}
+
+func newObject(t antidote.CRDTType) *antidote.ApbReadObjectResp {
+	switch t {
+	case antidote.CRDTType_COUNTER:
+		return &antidote.ApbReadObjectResp{Counter: &antidote.ApbGetCounterResp{Value: proto.Int32(0)}}
+	case antidote.CRDTType_ORSET:
+		return &antidote.ApbReadObjectResp{Set: &antidote.ApbGetSetResp{}}
+	case antidote.CRDTType_LWWREG:
+		return &antidote.ApbReadObjectResp{Reg: &antidote.ApbGetRegResp{Value: []byte{}}}
+	case antidote.CRDTType_MVREG:
+		return &antidote.ApbReadObjectResp{Mvreg: &antidote.ApbGetMVRegResp{}}
+	case antidote.CRDTType_FLAG_EW:
+		return &antidote.ApbReadObjectResp{Flag: &antidote.ApbGetFlagResp{Value: proto.Bool(false)}}
+	}
+	return &antidote.ApbReadObjectResp{Map: &antidote.ApbGetMapResp{}}
+}
+
+func apply(obj *antidote.ApbReadObjectResp, op *antidote.ApbUpdateOperation) {
+	switch {
+	case op.Counterop != nil:
+		inc := int64(1)
+		if op.Counterop.Inc != nil {
+			inc = op.Counterop.GetInc()
+		}
+		obj.Counter.Value = proto.Int32(obj.Counter.GetValue() + int32(inc))
+	case op.Setop != nil:
+		elems := make(map[string]bool)
+		for _, e := range obj.Set.Value {
+			elems[string(e)] = true
+		}
+		for _, e := range op.Setop.Adds {
+			elems[string(e)] = true
+		}
+		for _, e := range op.Setop.Rems {
+			delete(elems, string(e))
+		}
+		obj.Set.Value = sortedBytes(elems)
+	case op.Regop != nil && obj.Mvreg != nil:
+		obj.Mvreg.Values = [][]byte{op.Regop.Value}
+	case op.Regop != nil:
+		obj.Reg.Value = op.Regop.Value
+	case op.Flagop != nil:
+		obj.Flag.Value = op.Flagop.Value
+	case op.Mapop != nil:
+		for _, u := range op.Mapop.Updates {
+			entry := mapEntry(obj.Map, u.Key)
+			if entry == nil {
+				entry = &antidote.ApbMapEntry{Key: u.Key, Value: newObject(u.Key.GetType())}
+				obj.Map.Entries = append(obj.Map.Entries, entry)
+			}
+			apply(entry.Value, u.Update)
+		}
+		for _, k := range op.Mapop.RemovedKeys {
+			for i, e := range obj.Map.Entries {
+				if bytes.Equal(e.Key.Key, k.Key) && e.Key.GetType() == k.GetType() {
+					obj.Map.Entries = append(obj.Map.Entries[:i], obj.Map.Entries[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+func mapEntry(m *antidote.ApbGetMapResp, key *antidote.ApbMapKey) *antidote.ApbMapEntry {
+	for _, e := range m.Entries {
+		if bytes.Equal(e.Key.Key, key.Key) && e.Key.GetType() == key.GetType() {
+			return e
+		}
+	}
+	return nil
+}
+
+func sortedBytes(elems map[string]bool) [][]byte {
+	keys := make([]string, 0, len(elems))
+	for e := range elems {
+		keys = append(keys, e)
+	}
+	sort.Strings(keys)
+	res := make([][]byte, len(keys))
+	for i, k := range keys {
+		res[i] = []byte(k)
+	}
+	return res
+}
+
+// Sends a request to the gateway and decodes the JSON response into a generic value
+func request(t *testing.T, g *gateway, method, path, body string) (status int, resp map[string]interface{}) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+	if rec.Body.Len() > 0 {
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s %s: invalid response %q", method, path, rec.Body.String())
+		}
+	}
+	return rec.Code, resp
+}
+
+func TestGatewayObjects(t *testing.T) {
+	g := newGateway(newMemStore())
+	for _, r := range []struct {
+		method, path, body string
+	}{
+		{"POST", "/buckets/b/counters/c/inc", `{"amount": 5}`},
+		{"POST", "/buckets/b/counters/c/inc", `{}`},
+		{"POST", "/buckets/b/sets/s/add", `{"elems": ["x", "y", "z"]}`},
+		{"POST", "/buckets/b/sets/s/remove", `{"elems": ["y"]}`},
+		{"PUT", "/buckets/b/registers/r", `{"value": "hello"}`},
+		{"PUT", "/buckets/b/mvregisters/mv", `{"value": "hi"}`},
+		{"PUT", "/buckets/b/flags/f", `{"value": true}`},
+		{"POST", "/buckets/b/maps/m/update", `{"updates": [{"op": "put", "path": "name", "value": "alice"}, {"op": "inc", "path": "address/visits", "amount": 2}]}`},
+	} {
+		if status, resp := request(t, g, r.method, r.path, r.body); status != http.StatusNoContent {
+			t.Fatalf("%s %s: unexpected status %d: %v", r.method, r.path, status, resp)
+		}
+	}
+
+	for path, expected := range map[string]interface{}{
+		"/buckets/b/counters/c":     float64(6),
+		"/buckets/b/sets/s":         []interface{}{"x", "z"},
+		"/buckets/b/registers/r":    "hello",
+		"/buckets/b/mvregisters/mv": []interface{}{"hi"},
+		"/buckets/b/flags/f":        true,
+		"/buckets/b/maps/m":         map[string]interface{}{"name": "alice", "address": map[string]interface{}{"visits": float64(2)}},
+		"/buckets/b/counters/other": float64(0),
+	} {
+		status, resp := request(t, g, "GET", path, "")
+		if status != http.StatusOK || !reflect.DeepEqual(resp["value"], expected) {
+			t.Fatalf("GET %s: unexpected response %d %v", path, status, resp)
+		}
+	}
+}
+
+func TestGatewayTransaction(t *testing.T) {
+	store := newMemStore()
+	g := newGateway(store)
+	status, resp := request(t, g, "POST", "/transactions", `{"operations": [
+		{"bucket": "b", "type": "counter", "key": "c", "op": "inc", "amount": 3},
+		{"bucket": "b", "type": "set", "key": "s", "op": "add", "elems": ["a"]},
+		{"bucket": "b", "type": "counter", "key": "c", "op": "get"}
+	]}`)
+	if status != http.StatusOK || !reflect.DeepEqual(resp["results"], []interface{}{nil, nil, float64(3)}) {
+		t.Fatalf("unexpected response %d %v", status, resp)
+	}
+
+	// the transaction is aborted when an operation fails
+	status, resp = request(t, g, "POST", "/transactions", `{"operations": [
+		{"bucket": "b", "type": "counter", "key": "c", "op": "inc"},
+		{"bucket": "b", "type": "counter", "key": "c", "op": "put", "value": "x"}
+	]}`)
+	if status != http.StatusBadRequest || !strings.Contains(resp["error"].(string), "operation 1") {
+		t.Fatalf("unexpected response %d %v", status, resp)
+	}
+	if _, resp = request(t, g, "GET", "/buckets/b/counters/c", ""); resp["value"] != float64(3) {
+		t.Fatalf("expected aborted increment to be discarded, got %v", resp)
+	}
+}
+
+func TestGatewayInvalidRequests(t *testing.T) {
+	g := newGateway(newMemStore())
+	for _, r := range []struct {
+		method, path, body string
+	}{
+		{"GET", "/buckets/b/queues/q", ""},
+		{"POST", "/buckets/b/counters/c/add", `{}`},
+		{"POST", "/buckets/b/sets/s/add", `{}`},
+		{"PUT", "/buckets/b/flags/f", `{"value": "yes"}`},
+		{"POST", "/buckets/b/maps/m/update", `{"updates": [{"op": "remove", "path": "x", "types": ["nope"]}]}`},
+		{"POST", "/buckets/b/counters/c/inc", `{"amount": "many"}`},
+		{"POST", "/transactions", `{"operations": []}`},
+		{"POST", "/transactions", `{"ops": []}`},
+	} {
+		if status, resp := request(t, g, r.method, r.path, r.body); status != http.StatusBadRequest || resp["error"] == "" {
+			t.Fatalf("%s %s: expected status 400, got %d %v", r.method, r.path, status, resp)
+		}
+	}
+}
+
+func TestGatewayOpenAPI(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newGateway(newMemStore()).ServeHTTP(rec, httptest.NewRequest("GET", "/openapi.yaml", nil))
+	if rec.Code != http.StatusOK || !bytes.HasPrefix(rec.Body.Bytes(), []byte("openapi: 3")) {
+		t.Fatalf("unexpected response %d", rec.Code)
+	}
+}
@@ -0,0 +1,69 @@
+// Command antidote-gateway offers reads and updates of Antidote objects as an HTTP/JSON API.
+//
+//	antidote-gateway -listen :8080 -host 10.0.0.1:8087,10.0.0.2:8087
+//
+//	curl localhost:8080/buckets/bucket/counters/key
+//	curl -X POST -d '{"amount": 5}' localhost:8080/buckets/bucket/counters/key/inc
+//	curl -X POST -d '{"operations": [{"bucket": "bucket", "type": "set", "key": "key", "op": "add", "elems": ["a"]}]}' localhost:8080/transactions
+//
+// Requests on single objects are executed as static transactions, the operations of /transactions in an interactive transaction.
+// The API is described by the OpenAPI specification served at /openapi.yaml.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+)
+
+// Time given to pending requests and open transactions when the gateway is stopped
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	listen := flag.String("listen", ":8080", "`address` to serve HTTP on")
+	hosts := flag.String("host", "127.0.0.1:8087", "comma-separated Antidote hosts `name:port`")
+	flag.Parse()
+
+	var antidoteHosts []antidote.Host
+	for _, h := range strings.Split(*hosts, ",") {
+		host, err := antidote.ParseHost(h)
+		if err != nil {
+			log.Fatalf("invalid host %q: %v", h, err)
+		}
+		antidoteHosts = append(antidoteHosts, host)
+	}
+	client, err := antidote.NewClient(antidoteHosts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	server := &http.Server{Addr: *listen, Handler: newGateway(clientBackend{client})}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+	<-stopped
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := client.Shutdown(shutdownCtx); err != nil {
+		log.Print(err)
+	}
+}
@@ -0,0 +1,74 @@
+// Command antidote-proxy forwards the connections of Antidote clients over a limited number of connections to an Antidote node.
+//
+//	antidote-proxy -listen :8087 -upstream 10.0.0.1:8087 -connections 8 -rate 100 -record traffic.jsonl
+//
+// Interactive transactions stay on the connection they were started on until commit or abort.
+// With -record, every frame forwarded is written to the file as a line of JSON, in the format of antidote.Recorder,
+// which can be replayed with package replay.
+package main
+
+import (
+	"flag"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/proxy"
+)
+
+func main() {
+	listen := flag.String("listen", ":8087", "`address` to accept client connections on")
+	upstream := flag.String("upstream", "127.0.0.1:8087", "Antidote node `name:port`")
+	connections := flag.Int("connections", proxy.DefaultMaxUpstreamConnections, "maximum number of connections to Antidote")
+	requestRate := flag.Float64("rate", 0, "maximum requests per second of each client IP, 0 for no limit")
+	burst := flag.Int("burst", 1, "number of requests a client can send at once when rate limited")
+	maxFrame := flag.Uint("max-frame", proxy.DefaultMaxFrameSize, "maximum size in bytes of frames sent by clients")
+	idleTimeout := flag.Duration("idle-timeout", proxy.DefaultPinnedIdleTimeout, "disconnect clients silent for longer with open transactions, negative for no limit")
+	record := flag.String("record", "", "write forwarded frames to the `file` as JSON lines")
+	verbose := flag.Bool("v", false, "log every request")
+	flag.Parse()
+
+	level := slog.LevelInfo
+	if *verbose {
+		level = slog.LevelDebug
+	}
+	options := proxy.Options{
+		Upstream:               *upstream,
+		MaxUpstreamConnections: *connections,
+		RequestsPerSecond:      *requestRate,
+		Burst:                  *burst,
+		MaxFrameSize:           uint32(*maxFrame),
+		PinnedIdleTimeout:      *idleTimeout,
+		Logger:                 slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})),
+	}
+	if *record != "" {
+		f, err := os.Create(*record)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		options.Recorder = antidote.NewRecorder(f)
+	}
+
+	p, err := proxy.New(options)
+	if err != nil {
+		log.Fatal(err)
+	}
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		log.Fatal(err)
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		p.Close()
+	}()
+	if err := p.Serve(l); err != proxy.ErrClosed {
+		log.Fatal(err)
+	}
+}
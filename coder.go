@@ -1,14 +1,132 @@
 package antidoteclient
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"io"
+	"reflect"
+	"sync"
+	"time"
 
-	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/proto"
 )
 
+// Code identifying the type of a protocol-buffer message in a frame
+type MessageCode byte
+
+// Message codes of the Antidote protocol-buffer interface
+const (
+	MsgErrorResp                   MessageCode = 0
+	MsgOperationResp               MessageCode = 111
+	MsgReadObjects                 MessageCode = 116
+	MsgUpdateObjects               MessageCode = 118
+	MsgStartTransaction            MessageCode = 119
+	MsgAbortTransaction            MessageCode = 120
+	MsgCommitTransaction           MessageCode = 121
+	MsgStaticUpdateObjects         MessageCode = 122
+	MsgStaticReadObjects           MessageCode = 123
+	MsgStartTransactionResp        MessageCode = 124
+	MsgReadObjectsResp             MessageCode = 126
+	MsgCommitResp                  MessageCode = 127
+	MsgStaticReadObjectsResp       MessageCode = 128
+	MsgCreateDC                    MessageCode = 129
+	MsgCreateDCResp                MessageCode = 130
+	MsgConnectToDCs                MessageCode = 131
+	MsgConnectToDCsResp            MessageCode = 132
+	MsgGetConnectionDescriptor     MessageCode = 133
+	MsgGetConnectionDescriptorResp MessageCode = 134
+)
+
+// Describes the protocol-buffer message sent with a message code
+type messageType struct {
+	name string
+	new  func() proto.Message
+}
+
+// Registry of message types by code and codes by Go type
+var registry = struct {
+	sync.RWMutex
+	types map[MessageCode]messageType
+	codes map[reflect.Type]MessageCode
+}{
+	types: make(map[MessageCode]messageType),
+	codes: make(map[reflect.Type]MessageCode),
+}
+
+func init() {
+	RegisterMessage(MsgErrorResp, "ApbErrorResp", func() proto.Message { return &ApbErrorResp{} })
+	RegisterMessage(MsgOperationResp, "ApbOperationResp", func() proto.Message { return &ApbOperationResp{} })
+	RegisterMessage(MsgReadObjects, "ApbReadObjects", func() proto.Message { return &ApbReadObjects{} })
+	RegisterMessage(MsgUpdateObjects, "ApbUpdateObjects", func() proto.Message { return &ApbUpdateObjects{} })
+	RegisterMessage(MsgStartTransaction, "ApbStartTransaction", func() proto.Message { return &ApbStartTransaction{} })
+	RegisterMessage(MsgAbortTransaction, "ApbAbortTransaction", func() proto.Message { return &ApbAbortTransaction{} })
+	RegisterMessage(MsgCommitTransaction, "ApbCommitTransaction", func() proto.Message { return &ApbCommitTransaction{} })
+	RegisterMessage(MsgStaticUpdateObjects, "ApbStaticUpdateObjects", func() proto.Message { return &ApbStaticUpdateObjects{} })
+	RegisterMessage(MsgStaticReadObjects, "ApbStaticReadObjects", func() proto.Message { return &ApbStaticReadObjects{} })
+	RegisterMessage(MsgStartTransactionResp, "ApbStartTransactionResp", func() proto.Message { return &ApbStartTransactionResp{} })
+	RegisterMessage(MsgReadObjectsResp, "ApbReadObjectsResp", func() proto.Message { return &ApbReadObjectsResp{} })
+	RegisterMessage(MsgCommitResp, "ApbCommitResp", func() proto.Message { return &ApbCommitResp{} })
+	RegisterMessage(MsgStaticReadObjectsResp, "ApbStaticReadObjectsResp", func() proto.Message { return &ApbStaticReadObjectsResp{} })
+	RegisterMessage(MsgCreateDC, "ApbCreateDC", func() proto.Message { return &ApbCreateDC{} })
+	RegisterMessage(MsgCreateDCResp, "ApbCreateDCResp", func() proto.Message { return &ApbCreateDCResp{} })
+	RegisterMessage(MsgConnectToDCs, "ApbConnectToDCs", func() proto.Message { return &ApbConnectToDCs{} })
+	RegisterMessage(MsgConnectToDCsResp, "ApbConnectToDCsResp", func() proto.Message { return &ApbConnectToDCsResp{} })
+	RegisterMessage(MsgGetConnectionDescriptor, "ApbGetConnectionDescriptor", func() proto.Message { return &ApbGetConnectionDescriptor{} })
+	RegisterMessage(MsgGetConnectionDescriptorResp, "ApbGetConnectionDescriptorResp", func() proto.Message { return &ApbGetConnectionDescriptorResp{} })
+}
+
+// Registers the message type sent with the given code.
+// Allows to send and receive messages not covered by this package with RoundTrip.
+func RegisterMessage(code MessageCode, name string, newMessage func() proto.Message) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.types[code] = messageType{name: name, new: newMessage}
+	registry.codes[reflect.TypeOf(newMessage())] = code
+}
+
+func lookupMessage(code MessageCode) (t messageType, ok bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	t, ok = registry.types[code]
+	return
+}
+
+// Returns the code of the given message; false if its type is not registered
+func MessageCodeOf(message proto.Message) (code MessageCode, ok bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	code, ok = registry.codes[reflect.TypeOf(message)]
+	return
+}
+
+// Creates an empty message of the type sent with the given code; nil if the code is not registered
+func NewMessage(code MessageCode) proto.Message {
+	if t, ok := lookupMessage(code); ok {
+		return t.new()
+	}
+	return nil
+}
+
+// Returns the name of the message type sent with the code
+func (code MessageCode) String() string {
+	if t, ok := lookupMessage(code); ok {
+		return t.name
+	}
+	return fmt.Sprintf("unknown(%d)", byte(code))
+}
+
+// Implemented by connections that log the frames sent and received
+type frameLogger interface {
+	logFrame(sent bool, code MessageCode, payload []byte)
+}
+
 func readMsgRaw(reader io.Reader) (data []byte, err error) {
+	return readMsgLimit(reader, 0)
+}
+
+// Reads a frame like readMsgRaw; frames of more than maxSize bytes are rejected before allocating them, 0 means no limit.
+func readMsgLimit(reader io.Reader, maxSize uint32) (data []byte, err error) {
 	sizeB := make([]byte, 4)
 	var count uint32
 	// read the size of the message
@@ -21,6 +139,9 @@ func readMsgRaw(reader io.Reader) (data []byte, err error) {
 		count += uint32(n)
 	}
 	sizeI := binary.BigEndian.Uint32(sizeB)
+	if maxSize > 0 && sizeI > maxSize {
+		return nil, fmt.Errorf("%w: %d bytes, limit %d", ErrFrameTooLarge, sizeI, maxSize)
+	}
 	data = make([]byte, sizeI)
 	// read data
 	count = 0
@@ -32,222 +153,141 @@ func readMsgRaw(reader io.Reader) (data []byte, err error) {
 		}
 		count += uint32(n)
 	}
+	if l, ok := reader.(frameLogger); ok && len(data) > 0 {
+		l.logFrame(false, MessageCode(data[0]), data[1:])
+	}
 	return
 }
 
-func (op *ApbReadObjects) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 116, writer)
+// Reads a frame of the Antidote protocol and returns the message code and the encoded message.
+// Together with WriteFrame, allows to implement proxies and stand-ins speaking the protocol.
+func ReadFrame(reader io.Reader) (code MessageCode, payload []byte, err error) {
+	return ReadFrameLimit(reader, 0)
 }
 
-func (op *ApbUpdateObjects) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 118, writer)
-}
-
-func (op *ApbStartTransaction) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 119, writer)
-}
-
-func (op *ApbAbortTransaction) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 120, writer)
-}
-
-func (op *ApbCommitTransaction) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 121, writer)
-}
-
-func (op *ApbStaticUpdateObjects) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 122, writer)
-}
-
-func (op *ApbStaticReadObjects) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 123, writer)
-}
-
-func (op *ApbCreateDC) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 129, writer)
+// Reads a frame like ReadFrame, failing with ErrFrameTooLarge if its size exceeds maxSize bytes, 0 means no limit.
+// The size is checked before the frame is read, use it when reading from untrusted peers.
+func ReadFrameLimit(reader io.Reader, maxSize uint32) (code MessageCode, payload []byte, err error) {
+	data, err := readMsgLimit(reader, maxSize)
+	if err != nil {
+		return
+	}
+	if len(data) == 0 {
+		return 0, nil, fmt.Errorf("empty message")
+	}
+	return MessageCode(data[0]), data[1:], nil
 }
 
-func (op *ApbConnectToDCs) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 131, writer)
+// Writes a frame of the Antidote protocol with the message code and the encoded message.
+func WriteFrame(writer io.Writer, code MessageCode, payload []byte) error {
+	return encodeMsgRaw(code, payload, writer)
 }
 
-func (op *ApbGetConnectionDescriptor) encode(writer io.Writer) (err error) {
-	return encodeMsg(op, 133, writer)
+// Encodes the message with the code registered for its type.
+func encode(message proto.Message, writer io.Writer) (err error) {
+	code, ok := MessageCodeOf(message)
+	if !ok {
+		return fmt.Errorf("no message code registered for %T", message)
+	}
+	return encodeMsg(message, code, writer)
 }
 
+// Options used to marshal messages sent to Antidote
+var marshalOptions = proto.MarshalOptions{}
 
+// Buffers larger than this are not returned to the pool
+const maxPooledBufferSize = 1 << 16
 
-func encodeMsg(message proto.Message, msgCode byte, writer io.Writer) (err error) {
-	msg, err := proto.Marshal(message)
-	if err != nil {
-		return
-	}
-	msgsize := len(msg)
-	buf := make([]byte, 5)
-	binary.BigEndian.PutUint32(buf[0:4], uint32(msgsize+1))
-	buf[4] = msgCode
-	writer.Write(buf)
-	writer.Write(msg)
-	return nil
+// Pool of buffers for encoding frames
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 512)
+		return &buf
+	},
 }
 
-func decodeOperationResp(reader io.Reader) (op *ApbOperationResp, err error) {
-	data, err := readMsgRaw(reader)
-	if err != nil {
-		return
+func encodeMsg(message proto.Message, msgCode MessageCode, writer io.Writer) (err error) {
+	bufp := bufferPool.Get().(*[]byte)
+	// reserve space for the frame header and marshal the message after it
+	buf, err := marshalOptions.MarshalAppend(append((*bufp)[:0], 0, 0, 0, 0, byte(msgCode)), message)
+	if err == nil {
+		err = writeFrame(buf, writer)
 	}
-	switch data[0] {
-	case 111:
-		// transaction response
-		resp := &ApbOperationResp{}
-		err = proto.Unmarshal(data[1:], resp)
-		if err != nil {
-			return
-		}
-		op = resp
-		return
+	if cap(buf) <= maxPooledBufferSize {
+		*bufp = buf
+		bufferPool.Put(bufp)
 	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
 	return
 }
 
-func decodeStartTransactionResp(reader io.Reader) (op *ApbStartTransactionResp, err error) {
-	data, err := readMsgRaw(reader)
-	if err != nil {
-		return
-	}
-	switch data[0] {
-	case 124:
-		// transaction response
-		resp := &ApbStartTransactionResp{}
-		err = proto.Unmarshal(data[1:], resp)
-		if err != nil {
-			return
-		}
-		op = resp
-		return
-	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
-	return
+func encodeMsgRaw(msgCode MessageCode, msg []byte, writer io.Writer) (err error) {
+	buf := make([]byte, 5, 5+len(msg))
+	buf[4] = byte(msgCode)
+	return writeFrame(append(buf, msg...), writer)
 }
 
-func decodeReadObjectsResp(reader io.Reader) (op *ApbReadObjectsResp, err error) {
-	data, err := readMsgRaw(reader)
-	if err != nil {
-		return
-	}
-	switch data[0] {
-	case 126:
-		// transaction response
-		resp := &ApbReadObjectsResp{}
-		err = proto.Unmarshal(data[1:], resp)
-		if err != nil {
-			return
-		}
-		op = resp
-		return
-	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
-	return
-}
-
-func decodeCommitResp(reader io.Reader) (op *ApbCommitResp, err error) {
-	data, err := readMsgRaw(reader)
-	if err != nil {
-		return
-	}
-	switch data[0] {
-	case 127:
-		// transaction response
-		resp := &ApbCommitResp{}
-		err = proto.Unmarshal(data[1:], resp)
-		if err != nil {
-			return
-		}
-		op = resp
-		return
+// Writes a frame consisting of a header with space for the size and the message code, followed by the message.
+// Fills in the size before writing.
+func writeFrame(frame []byte, writer io.Writer) (err error) {
+	binary.BigEndian.PutUint32(frame[0:4], uint32(len(frame)-4))
+	if l, ok := writer.(frameLogger); ok {
+		l.logFrame(true, MessageCode(frame[4]), frame[5:])
 	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
+	_, err = writer.Write(frame)
 	return
 }
 
-func decodeStaticReadObjectsResp(reader io.Reader) (op *ApbStaticReadObjectsResp, err error) {
+// Reads a message and decodes it into resp.
+// Returns a *ServerError if Antidote answers with an ApbErrorResp.
+func decode(reader io.Reader, resp proto.Message) (err error) {
 	data, err := readMsgRaw(reader)
 	if err != nil {
 		return
 	}
-	switch data[0] {
-	case 128:
-		// transaction response
-		resp := &ApbStaticReadObjectsResp{}
-		err = proto.Unmarshal(data[1:], resp)
-		if err != nil {
-			return
-		}
-		op = resp
-		return
+	if len(data) == 0 {
+		return fmt.Errorf("empty message")
 	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
-	return
-}
-
-
-func decodeApbCreateDCResp(reader io.Reader) (op *ApbCreateDCResp, err error) {
-	data, err := readMsgRaw(reader)
-	if err != nil {
-		return
-	}
-	switch data[0] {
-	case 130:
-		// transaction response
-		resp := &ApbCreateDCResp{}
-		err = proto.Unmarshal(data[1:], resp)
+	code := MessageCode(data[0])
+	// the frame is read in any case, to keep the connection usable
+	expected, ok := MessageCodeOf(resp)
+	switch {
+	case code == MsgErrorResp:
+		errResp := &ApbErrorResp{}
+		err = proto.Unmarshal(data[1:], errResp)
 		if err != nil {
 			return
 		}
-		op = resp
-		return
+		return &ServerError{Code: errResp.GetErrcode(), Message: string(errResp.GetErrmsg())}
+	case !ok:
+		return fmt.Errorf("no message code registered for %T", resp)
+	case code == expected:
+		return proto.Unmarshal(data[1:], resp)
 	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
-	return
+	return fmt.Errorf("invalid message code: %d (%s), expected %d (%s)", code, code, expected, expected)
 }
 
-func decodeApbConnectToDCsResp(reader io.Reader) (op *ApbConnectToDCsResp, err error) {
-	data, err := readMsgRaw(reader)
+// Sends the request and decodes the response into resp.
+// Returns a *ServerError if Antidote answers with an ApbErrorResp.
+func roundTrip(con io.ReadWriter, req proto.Message, resp proto.Message) (err error) {
+	err = encode(req, con)
 	if err != nil {
 		return
 	}
-	switch data[0] {
-	case 132:
-		// transaction response
-		resp := &ApbConnectToDCsResp{}
-		err = proto.Unmarshal(data[1:], resp)
-		if err != nil {
-			return
-		}
-		op = resp
-		return
-	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
-	return
+	return decode(con, resp)
 }
 
-func decodeApbGetConnectionDescriptorResp(reader io.Reader) (op *ApbGetConnectionDescriptorResp, err error) {
-	data, err := readMsgRaw(reader)
-	if err != nil {
-		return
+// Sends the request and decodes the response like roundTrip, unblocking the round trip when the context is done.
+// Returns the error of the context if it is done before the response is decoded;
+// the connection may carry an unread response then and cannot be reused.
+func roundTripContext(ctx context.Context, con *connection, req proto.Message, resp proto.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
-	switch data[0] {
-	case 134:
-		// transaction response
-		resp := &ApbGetConnectionDescriptorResp{}
-		err = proto.Unmarshal(data[1:], resp)
-		if err != nil {
-			return
-		}
-		op = resp
-		return
+	stop := context.AfterFunc(ctx, func() { con.SetDeadline(time.Now()) })
+	err := roundTrip(con, req, resp)
+	if !stop() && ctx.Err() != nil {
+		// the deadline may have been set after the round trip
+		return ctx.Err()
 	}
-	err = fmt.Errorf("invalid message code: %d", data[0])
-	return
+	return err
 }
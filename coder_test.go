@@ -0,0 +1,140 @@
+package antidoteclient
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMessageRegistry(t *testing.T) {
+	if code, ok := MessageCodeOf(&ApbReadObjects{}); !ok || code != MsgReadObjects {
+		t.Fatalf("unexpected code for ApbReadObjects: %d", code)
+	}
+	if _, ok := NewMessage(MsgCommitResp).(*ApbCommitResp); !ok {
+		t.Fatal("expected ApbCommitResp for MsgCommitResp")
+	}
+	if MsgStaticUpdateObjects.String() != "ApbStaticUpdateObjects" || MessageCode(200).String() != "unknown(200)" {
+		t.Fatalf("unexpected names: %s %s", MsgStaticUpdateObjects, MessageCode(200))
+	}
+}
+
+func TestDecodeErrorResp(t *testing.T) {
+	buf := &bytes.Buffer{}
+	errcode := uint32(5)
+	encodeMsg(&ApbErrorResp{Errmsg: []byte("unknown transaction"), Errcode: &errcode}, MsgErrorResp, buf)
+	err := decode(buf, &ApbCommitResp{})
+	serr, ok := err.(*ServerError)
+	if !ok || serr.Code != 5 || serr.Message != "unknown transaction" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	success := true
+	encodeMsg(&ApbOperationResp{Success: &success}, MsgOperationResp, buf)
+	err = decode(buf, &ApbCommitResp{})
+	if err == nil || err.Error() != "invalid message code: 111 (ApbOperationResp), expected 127 (ApbCommitResp)" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClientRoundTripUnregisteredResponse(t *testing.T) {
+	errcode := uint32(3)
+	server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		if code == MsgStaticReadObjects {
+			return MsgErrorResp, &ApbErrorResp{Errmsg: []byte("aborted"), Errcode: &errcode}
+		}
+		return ackHandler(code, data)
+	})
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// ApbMapKey is not registered as a message, error responses must not be decoded into it
+	var serr *ServerError
+	if err = client.RoundTrip(&ApbStaticReadObjects{Transaction: &ApbStartTransaction{}}, &ApbMapKey{}); !errors.As(err, &serr) || serr.Code != 3 {
+		t.Fatalf("expected server error, got %v", err)
+	}
+	if err = client.RoundTrip(&ApbStaticUpdateObjects{Transaction: &ApbStartTransaction{}}, &ApbMapKey{}); err == nil || errors.As(err, new(*ServerError)) {
+		t.Fatalf("expected error for unregistered response type, got %v", err)
+	}
+}
+
+func TestFrames(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := WriteFrame(buf, MsgReadObjects, []byte("payload")); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), []byte("\x00\x00\x00\x08\x74payload")) {
+		t.Fatalf("unexpected frame %q", buf.Bytes())
+	}
+	code, payload, err := ReadFrame(buf)
+	if err != nil || code != MsgReadObjects || string(payload) != "payload" {
+		t.Fatalf("unexpected frame %d %q (%v)", code, payload, err)
+	}
+	if _, _, err = ReadFrame(bytes.NewReader([]byte{0, 0, 0, 0})); err == nil {
+		t.Fatal("expected error for empty frame")
+	}
+	if _, _, err = ReadFrameLimit(bytes.NewReader([]byte("\x00\x00\x00\x08\x74payload")), 8); err != nil {
+		t.Fatalf("expected frame within the limit, got %v", err)
+	}
+	if _, _, err = ReadFrameLimit(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff}), 8); !errors.Is(err, ErrFrameTooLarge) {
+		t.Fatalf("expected ErrFrameTooLarge, got %v", err)
+	}
+}
+
+func TestClientRoundTrip(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	resp := &ApbCommitResp{}
+	if err = client.RoundTrip(&ApbStaticUpdateObjects{Transaction: &ApbStartTransaction{}}, resp); err != nil {
+		t.Fatal(err)
+	}
+	if !resp.GetSuccess() || string(resp.CommitTime) != "ct" {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+
+	payload, _ := proto.Marshal(&ApbStartTransaction{})
+	code, data, err := client.RoundTripRaw(MsgStartTransaction, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	startResp := &ApbStartTransactionResp{}
+	if code != MsgStartTransactionResp || proto.Unmarshal(data, startResp) != nil || string(startResp.TransactionDescriptor) != "tx" {
+		t.Fatalf("unexpected raw response: %d %v", code, startResp)
+	}
+
+	// an empty frame is an error, as for ReadFrame
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		con, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer con.Close()
+		if _, err = readMsgRaw(con); err == nil {
+			con.Write([]byte{0, 0, 0, 0})
+		}
+	}()
+	addr := l.Addr().(*net.TCPAddr)
+	empty, err := NewClient(Host{addr.IP.String(), addr.Port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer empty.Close()
+	if _, _, err = empty.RoundTripRaw(MsgStartTransaction, payload); err == nil {
+		t.Fatal("expected error for empty frame")
+	}
+}
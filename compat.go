@@ -0,0 +1,708 @@
+package antidoteclient
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Compatibility with code written against the messages generated by the deprecated
+// github.com/golang/protobuf v1 generator.
+// The messages in antidote.pb.go are generated with the APIv2 generator and can be used with
+// both google.golang.org/protobuf and github.com/golang/protobuf.
+// The XXX_ methods the v1 generator added to every message are provided below.
+// New code should use the functions of google.golang.org/protobuf/proto instead.
+
+func xxxUnmarshal(m proto.Message, b []byte) error {
+	return proto.UnmarshalOptions{Merge: true}.Unmarshal(b, m)
+}
+
+func xxxMarshal(m proto.Message, b []byte, deterministic bool) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: deterministic}.MarshalAppend(b, m)
+}
+
+func xxxMerge(m proto.Message, src protoadapt.MessageV1) {
+	proto.Merge(m, protoadapt.MessageV2Of(src))
+}
+
+// Removes unknown fields from the message and its nested messages
+func xxxDiscardUnknown(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil:
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				xxxDiscardUnknown(v.List().Get(i).Message())
+			}
+		default:
+			xxxDiscardUnknown(v.Message())
+		}
+		return true
+	})
+	if m.GetUnknown() != nil {
+		m.SetUnknown(nil)
+	}
+}
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbErrorResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbErrorResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbErrorResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbErrorResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbErrorResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbCounterUpdate) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbCounterUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbCounterUpdate) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbCounterUpdate) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbCounterUpdate) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetCounterResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetCounterResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetCounterResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetCounterResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetCounterResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbSetUpdate) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbSetUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbSetUpdate) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbSetUpdate) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbSetUpdate) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetSetResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetSetResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetSetResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetSetResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetSetResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbRegUpdate) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbRegUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbRegUpdate) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbRegUpdate) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbRegUpdate) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetRegResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetRegResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetRegResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetRegResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetRegResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetMVRegResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetMVRegResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetMVRegResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetMVRegResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetMVRegResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbMapKey) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbMapKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbMapKey) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbMapKey) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbMapKey) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbMapUpdate) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbMapUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbMapUpdate) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbMapUpdate) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbMapUpdate) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbMapNestedUpdate) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbMapNestedUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbMapNestedUpdate) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbMapNestedUpdate) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbMapNestedUpdate) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetMapResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetMapResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetMapResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetMapResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetMapResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbMapEntry) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbMapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbMapEntry) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbMapEntry) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbMapEntry) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbFlagUpdate) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbFlagUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbFlagUpdate) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbFlagUpdate) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbFlagUpdate) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetFlagResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetFlagResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetFlagResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetFlagResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetFlagResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbCrdtReset) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbCrdtReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbCrdtReset) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbCrdtReset) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbCrdtReset) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbOperationResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbOperationResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbOperationResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbOperationResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbOperationResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbTxnProperties) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbTxnProperties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbTxnProperties) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbTxnProperties) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbTxnProperties) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbBoundObject) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbBoundObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbBoundObject) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbBoundObject) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbBoundObject) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbReadObjects) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbReadObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbReadObjects) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbReadObjects) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbReadObjects) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbUpdateOp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbUpdateOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbUpdateOp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbUpdateOp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbUpdateOp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbUpdateOperation) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbUpdateOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbUpdateOperation) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbUpdateOperation) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbUpdateOperation) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbUpdateObjects) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbUpdateObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbUpdateObjects) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbUpdateObjects) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbUpdateObjects) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbStartTransaction) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbStartTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbStartTransaction) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbStartTransaction) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbStartTransaction) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbAbortTransaction) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbAbortTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbAbortTransaction) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbAbortTransaction) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbAbortTransaction) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbCommitTransaction) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbCommitTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbCommitTransaction) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbCommitTransaction) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbCommitTransaction) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbStaticUpdateObjects) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbStaticUpdateObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbStaticUpdateObjects) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbStaticUpdateObjects) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbStaticUpdateObjects) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbStaticReadObjects) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbStaticReadObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbStaticReadObjects) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbStaticReadObjects) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbStaticReadObjects) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbStartTransactionResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbStartTransactionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbStartTransactionResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbStartTransactionResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbStartTransactionResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbReadObjectResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbReadObjectResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbReadObjectResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbReadObjectResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbReadObjectResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbReadObjectsResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbReadObjectsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbReadObjectsResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbReadObjectsResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbReadObjectsResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbCommitResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbCommitResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbCommitResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbCommitResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbCommitResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbStaticReadObjectsResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbStaticReadObjectsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbStaticReadObjectsResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbStaticReadObjectsResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbStaticReadObjectsResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbCreateDC) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbCreateDC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbCreateDC) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbCreateDC) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbCreateDC) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbCreateDCResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbCreateDCResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbCreateDCResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbCreateDCResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbCreateDCResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetConnectionDescriptor) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetConnectionDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetConnectionDescriptor) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetConnectionDescriptor) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetConnectionDescriptor) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbGetConnectionDescriptorResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbGetConnectionDescriptorResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbGetConnectionDescriptorResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbGetConnectionDescriptorResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbGetConnectionDescriptorResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbConnectToDCs) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbConnectToDCs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbConnectToDCs) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbConnectToDCs) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbConnectToDCs) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
+
+// Deprecated: Use proto.Unmarshal instead.
+func (m *ApbConnectToDCsResp) XXX_Unmarshal(b []byte) error { return xxxUnmarshal(m, b) }
+
+// Deprecated: Use proto.Marshal instead.
+func (m *ApbConnectToDCsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxxMarshal(m, b, deterministic)
+}
+
+// Deprecated: Use proto.Merge instead.
+func (m *ApbConnectToDCsResp) XXX_Merge(src protoadapt.MessageV1) { xxxMerge(m, src) }
+
+// Deprecated: Use proto.Size instead.
+func (m *ApbConnectToDCsResp) XXX_Size() int { return proto.Size(m) }
+
+// Deprecated: Do not use.
+func (m *ApbConnectToDCsResp) XXX_DiscardUnknown() { xxxDiscardUnknown(m.ProtoReflect()) }
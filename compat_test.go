@@ -0,0 +1,40 @@
+package antidoteclient
+
+import (
+	"bytes"
+	"testing"
+
+	protov1 "github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestV1Compatibility(t *testing.T) {
+	crdtType := CRDTType_COUNTER
+	obj := &ApbBoundObject{Key: []byte("key"), Type: &crdtType, Bucket: []byte("bucket")}
+
+	// messages can still be used with the v1 proto package
+	v1, err := protov1.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v2, err := proto.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(v1, v2) {
+		t.Fatalf("v1 and v2 encodings differ: %x, %x", v1, v2)
+	}
+	if obj.XXX_Size() != len(v2) {
+		t.Fatalf("unexpected size %d, expected %d", obj.XXX_Size(), len(v2))
+	}
+
+	decoded := &ApbBoundObject{}
+	if err = decoded.XXX_Unmarshal(v1); err != nil {
+		t.Fatal(err)
+	}
+	merged := &ApbBoundObject{}
+	merged.XXX_Merge(decoded)
+	if !protov1.Equal(merged, obj) {
+		t.Fatalf("unexpected message after merge: %v", merged)
+	}
+}
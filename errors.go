@@ -0,0 +1,84 @@
+package antidoteclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Returned when Antidote reports that an operation was not successful.
+type ServerError struct {
+	// Error code sent by Antidote
+	Code uint32
+	// Error message, only sent with error responses (ApbErrorResp)
+	Message string
+}
+
+func (err *ServerError) Error() string {
+	if err.Message != "" {
+		return fmt.Sprintf("operation not successful; error code %d: %s", err.Code, err.Message)
+	}
+	return fmt.Sprintf("operation not successful; error code %d", err.Code)
+}
+
+// Returned by the cluster management operations CreateDc, GetConnectionDescriptor and ConnectToDCs.
+type ClusterError struct {
+	// The failed operation
+	Op Operation
+	// Host the request was sent to, empty if no connection could be obtained
+	Host Host
+	// Cause of the failure; a *ServerError if Antidote reported an error code
+	Err error
+}
+
+func (err *ClusterError) Error() string {
+	if err.Host == (Host{}) {
+		return fmt.Sprintf("%s failed: %v", err.Op, err.Err)
+	}
+	return fmt.Sprintf("%s on %s failed: %v", err.Op, err.Host, err.Err)
+}
+
+func (err *ClusterError) Unwrap() error {
+	return err.Err
+}
+
+// Returns the error code reported by Antidote, 0 if the operation failed on the client side
+func (err *ClusterError) Code() uint32 {
+	var serr *ServerError
+	if errors.As(err.Err, &serr) {
+		return serr.Code
+	}
+	return 0
+}
+
+// Returned when an operation targets a host that was not given when creating the client.
+var ErrUnknownHost = errors.New("host is not connected by the client")
+
+// Returned when a connection is requested from a closed client.
+var ErrClientClosed = errors.New("client is closed")
+
+// Matches the errors returned by operations on interactive transactions that are no longer active, see TxDoneError.
+var ErrTxDone = errors.New("transaction has already been committed or aborted")
+
+// Reported for interactive transactions garbage collected without commit or abort
+var ErrTxLeaked = errors.New("transaction garbage collected without commit or abort")
+
+// Reported for interactive transactions aborted by the client after exceeding the maximum lifetime
+var ErrTxExpired = errors.New("transaction exceeded maximum lifetime")
+
+// Returned by ReadFrameLimit for frames larger than the limit
+var ErrFrameTooLarge = errors.New("frame exceeds maximum size")
+
+// Returned by operations on interactive transactions that are no longer active.
+// Matches ErrTxDone with errors.Is, and ErrTxExpired if the transaction expired.
+type TxDoneError struct {
+	// State of the transaction
+	State TxState
+}
+
+func (err *TxDoneError) Error() string {
+	return fmt.Sprintf("transaction is %s", err.State)
+}
+
+func (err *TxDoneError) Is(target error) bool {
+	return target == ErrTxDone || (err.State == TxExpired && target == ErrTxExpired)
+}
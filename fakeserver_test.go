@@ -0,0 +1,108 @@
+package antidoteclient
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// A request received by a fakeServer
+type fakeRequest struct {
+	code MessageCode
+	data []byte
+}
+
+// Handles a request to a fakeServer and returns the code and message of the response
+type fakeHandler func(code MessageCode, data []byte) (respCode MessageCode, resp proto.Message)
+
+// Minimal stand-in for an Antidote server speaking the protocol-buffer framing.
+// Used to test the client without a running Antidote instance.
+type fakeServer struct {
+	listener net.Listener
+	handler  fakeHandler
+
+	mutex    sync.Mutex
+	requests []fakeRequest
+}
+
+func newFakeServer(t *testing.T, handler fakeHandler) *fakeServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{listener: l, handler: handler}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *fakeServer) host() Host {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return Host{addr.IP.String(), addr.Port}
+}
+
+func (s *fakeServer) serve() {
+	for {
+		con, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(con)
+	}
+}
+
+func (s *fakeServer) handle(con net.Conn) {
+	defer con.Close()
+	for {
+		data, err := readMsgRaw(con)
+		if err != nil {
+			return
+		}
+		s.mutex.Lock()
+		s.requests = append(s.requests, fakeRequest{code: MessageCode(data[0]), data: data[1:]})
+		s.mutex.Unlock()
+		respCode, resp := s.handler(MessageCode(data[0]), data[1:])
+		if resp == nil {
+			return
+		}
+		if encodeMsg(resp, respCode, con) != nil {
+			return
+		}
+	}
+}
+
+// Returns the requests received so far with the given message code
+func (s *fakeServer) received(code MessageCode) []fakeRequest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var res []fakeRequest
+	for _, r := range s.requests {
+		if r.code == code {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// Acknowledges every request with a successful response of the matching type
+func ackHandler(code MessageCode, data []byte) (MessageCode, proto.Message) {
+	success := true
+	switch code {
+	case MsgReadObjects:
+		return MsgReadObjectsResp, &ApbReadObjectsResp{Success: &success}
+	case MsgUpdateObjects, MsgAbortTransaction:
+		return MsgOperationResp, &ApbOperationResp{Success: &success}
+	case MsgStartTransaction:
+		return MsgStartTransactionResp, &ApbStartTransactionResp{Success: &success, TransactionDescriptor: []byte("tx")}
+	case MsgCommitTransaction, MsgStaticUpdateObjects:
+		return MsgCommitResp, &ApbCommitResp{Success: &success, CommitTime: []byte("ct")}
+	case MsgStaticReadObjects:
+		return MsgStaticReadObjectsResp, &ApbStaticReadObjectsResp{
+			Objects:    &ApbReadObjectsResp{Success: &success},
+			Committime: &ApbCommitResp{Success: &success, CommitTime: []byte("ct")},
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,71 @@
+package antidoteclient
+
+import "context"
+
+// Handle issuing operations to a single host of a client.
+// Used to read from a specific replica or to run cluster management operations on a chosen node.
+// Shares the connection pool of the host with the client.
+type HostClient struct {
+	client *Client
+	pool   *hostPool
+}
+
+// Returns a handle whose transactions and operations only use connections to the given host.
+// Fails with ErrUnknownHost if the host was not given when creating the client.
+func (client *Client) OnHost(host Host) (*HostClient, error) {
+	p, err := client.hostPool(host)
+	if err != nil {
+		return nil, err
+	}
+	return &HostClient{client: client, pool: p}, nil
+}
+
+// The host of the handle
+func (hc *HostClient) Host() Host {
+	return hc.pool.host
+}
+
+// Starts an interactive transaction on the host.
+func (hc *HostClient) StartTransaction() (*InteractiveTransaction, error) {
+	return hc.client.startTransaction(context.Background(), hc.pool)
+}
+
+// Starts an interactive transaction on the host, see Client.StartTransactionContext.
+func (hc *HostClient) StartTransactionContext(ctx context.Context) (*InteractiveTransaction, error) {
+	return hc.client.startTransaction(ctx, hc.pool)
+}
+
+// Creates a static transaction whose reads and updates are sent to the host.
+func (hc *HostClient) CreateStaticTransaction() *StaticTransaction {
+	return &StaticTransaction{client: hc.client, pool: hc.pool}
+}
+
+// Creates a data center with the given node names on the host
+func (hc *HostClient) CreateDc(nodeNames []string) error {
+	return hc.client.createDc(context.Background(), hc.pool, nodeNames)
+}
+
+// Creates a data center like CreateDc; the request is interrupted when the context is done.
+func (hc *HostClient) CreateDcContext(ctx context.Context, nodeNames []string) error {
+	return hc.client.createDc(ctx, hc.pool, nodeNames)
+}
+
+// Get a connection descriptor for the data center of the host
+func (hc *HostClient) GetConnectionDescriptor() ([]byte, error) {
+	return hc.client.getConnectionDescriptor(context.Background(), hc.pool)
+}
+
+// Gets a connection descriptor like GetConnectionDescriptor; the request is interrupted when the context is done.
+func (hc *HostClient) GetConnectionDescriptorContext(ctx context.Context) ([]byte, error) {
+	return hc.client.getConnectionDescriptor(ctx, hc.pool)
+}
+
+// Connects the data center of the host to the data centers with the given descriptors.
+func (hc *HostClient) ConnectToDCs(descriptors [][]byte) error {
+	return hc.client.connectToDCs(context.Background(), hc.pool, descriptors)
+}
+
+// Connects the data center like ConnectToDCs; the request is interrupted when the context is done.
+func (hc *HostClient) ConnectToDCsContext(ctx context.Context, descriptors [][]byte) error {
+	return hc.client.connectToDCs(ctx, hc.pool, descriptors)
+}
@@ -0,0 +1,69 @@
+package antidoteclient
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOnHost(t *testing.T) {
+	first, second := newFakeServer(t, ackHandler), newFakeServer(t, ackHandler)
+	client, err := NewClient(first.host(), second.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	bucket := Bucket{[]byte("bucket")}
+
+	hc, err := client.OnHost(second.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if err = bucket.Update(hc.CreateStaticTransaction(), CounterInc(Key("a"), 1)); err != nil {
+			t.Fatal(err)
+		}
+		tx, err := hc.StartTransaction()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = bucket.Update(tx, CounterInc(Key("a"), 1)); err != nil {
+			t.Fatal(err)
+		}
+		if err = tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(first.received(MsgStaticUpdateObjects))+len(first.received(MsgStartTransaction)) != 0 {
+		t.Fatal("expected no requests to the first host")
+	}
+	if n := len(second.received(MsgStaticUpdateObjects)); n != 10 {
+		t.Fatalf("expected 10 static updates on the second host, got %d", n)
+	}
+	if n := len(second.received(MsgStartTransaction)); n != 10 {
+		t.Fatalf("expected 10 transactions on the second host, got %d", n)
+	}
+
+	if _, err = client.OnHost(Host{"10.0.0.1", 8087}); !errors.Is(err, ErrUnknownHost) {
+		t.Fatalf("expected ErrUnknownHost, got %v", err)
+	}
+}
+
+func TestHostString(t *testing.T) {
+	for host, expected := range map[Host]string{
+		{"127.0.0.1", 8087}: "127.0.0.1:8087",
+		{"antidote", 8087}:  "antidote:8087",
+		{"::1", 8087}:       "[::1]:8087",
+	} {
+		if host.String() != expected {
+			t.Fatalf("expected %s, got %s", expected, host.String())
+		}
+		if parsed, err := ParseHost(expected); err != nil || parsed != host {
+			t.Fatalf("expected %v, parsed %v (%v)", host, parsed, err)
+		}
+	}
+	for _, invalid := range []string{"antidote", "antidote:port", "::1:8087"} {
+		if _, err := ParseHost(invalid); err == nil {
+			t.Fatalf("expected error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Package antidotetest provides a stand-in for Antidote to test the packages built on the client.
+package antidotetest
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"google.golang.org/protobuf/proto"
+)
+
+// Answers a request received on the connection with the given number, counted from 1.
+// Returns the message code and message of the response, or a nil message to close the connection.
+// Called concurrently for requests received on different connections.
+type Handler func(con int, code antidote.MessageCode, req proto.Message) (antidote.MessageCode, proto.Message)
+
+// Minimal stand-in for Antidote listening on a local port and answering requests with a Handler
+type Server struct {
+	listener net.Listener
+	handler  Handler
+
+	mutex       sync.Mutex
+	connections int
+}
+
+// Starts a server answering requests with the handler. The server is stopped when the test ends.
+func NewServer(t testing.TB, handler Handler) *Server {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{listener: l, handler: handler}
+	t.Cleanup(func() { l.Close() })
+	go s.serve()
+	return s
+}
+
+// Returns the host to connect clients to
+func (s *Server) Host() antidote.Host {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return antidote.Host{Name: addr.IP.String(), Port: addr.Port}
+}
+
+// Returns the number of connections accepted
+func (s *Server) Connections() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.connections
+}
+
+func (s *Server) serve() {
+	for {
+		con, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mutex.Lock()
+		s.connections++
+		id := s.connections
+		s.mutex.Unlock()
+		go s.handle(con, id)
+	}
+}
+
+func (s *Server) handle(con net.Conn, id int) {
+	defer con.Close()
+	for {
+		code, payload, err := antidote.ReadFrame(con)
+		if err != nil {
+			return
+		}
+		req := antidote.NewMessage(code)
+		if req == nil || proto.Unmarshal(payload, req) != nil {
+			return
+		}
+		respCode, resp := s.handler(id, code, req)
+		if resp == nil {
+			return
+		}
+		data, err := proto.Marshal(resp)
+		if err != nil || antidote.WriteFrame(con, respCode, data) != nil {
+			return
+		}
+	}
+}
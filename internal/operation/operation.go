@@ -0,0 +1,225 @@
+// Package operation executes the reads and updates of objects shared by antidote-cli and antidote-gateway.
+package operation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+)
+
+// Types of objects, as named in operations
+const (
+	Counter    = "counter"
+	Set        = "set"
+	Register   = "register"
+	MVRegister = "mvregister"
+	Flag       = "flag"
+	Map        = "map"
+)
+
+// Reads or updates an object. Values of registers and map entries are strings.
+type Operation struct {
+	Bucket string `json:"bucket"`
+	Type   string `json:"type"`
+	Key    string `json:"key"`
+	// get, inc, add, remove, put or update, depending on the type
+	Op string `json:"op"`
+	// Increment of counters, defaults to 1
+	Amount *int64 `json:"amount,omitempty"`
+	// Elements added to or removed from sets
+	Elems []string `json:"elems,omitempty"`
+	// Value put into registers, a string, and flags, a bool or a string parsed by strconv.ParseBool
+	Value interface{} `json:"value,omitempty"`
+	// Updates of map entries
+	Updates []MapOperation `json:"updates,omitempty"`
+}
+
+// Updates an entry of a map, see antidote.MapUpdateBuilder
+type MapOperation struct {
+	// put, mvput, inc, add, remove-elems, flag or remove
+	Op string `json:"op"`
+	// Path of the entry, segments separated by /
+	Path   string      `json:"path"`
+	Amount *int64      `json:"amount,omitempty"`
+	Elems  []string    `json:"elems,omitempty"`
+	Value  interface{} `json:"value,omitempty"`
+	// Types of the entries removed, all types if empty
+	Types []string `json:"types,omitempty"`
+}
+
+// Returned for operations that are incomplete or unknown
+type InvalidError struct {
+	msg string
+}
+
+func (err *InvalidError) Error() string {
+	return err.msg
+}
+
+func invalid(format string, args ...interface{}) error {
+	return &InvalidError{fmt.Sprintf(format, args...)}
+}
+
+// Executes an operation in the context of the transaction.
+// Returns the value read, or nil for updates; values of sets and multi-value registers as []string,
+// of registers as string and of maps as returned by antidote.MapReadResult.ToGo.
+func Execute(tx antidote.Transaction, o Operation) (result interface{}, err error) {
+	if o.Bucket == "" || o.Key == "" {
+		return nil, invalid("expected bucket and key")
+	}
+	bucket, key := &antidote.Bucket{Bucket: []byte(o.Bucket)}, antidote.Key(o.Key)
+	switch o.Type + " " + o.Op {
+	case "counter get":
+		return bucket.ReadCounter(tx, key)
+	case "counter inc":
+		return nil, bucket.Update(tx, antidote.CounterInc(key, amount(o.Amount)))
+	case "set get":
+		val, err := bucket.ReadSet(tx, key)
+		return toStrings(val), err
+	case "set add", "set remove":
+		if len(o.Elems) == 0 {
+			return nil, invalid("expected elements")
+		}
+		if o.Op == "add" {
+			return nil, bucket.Update(tx, antidote.SetAdd(key, toBytes(o.Elems)...))
+		}
+		return nil, bucket.Update(tx, antidote.SetRemove(key, toBytes(o.Elems)...))
+	case "register get":
+		val, err := bucket.ReadReg(tx, key)
+		return string(val), err
+	case "register put", "mvregister put":
+		value, err := stringValue(o.Value)
+		if err != nil {
+			return nil, err
+		}
+		if o.Type == Register {
+			return nil, bucket.Update(tx, antidote.RegPut(key, []byte(value)))
+		}
+		return nil, bucket.Update(tx, antidote.MVRegPut(key, []byte(value)))
+	case "mvregister get":
+		val, err := bucket.ReadMVReg(tx, key)
+		return toStrings(val), err
+	case "flag get":
+		return bucket.ReadFlag(tx, key)
+	case "flag put":
+		value, err := boolValue(o.Value)
+		if err != nil {
+			return nil, err
+		}
+		return nil, bucket.Update(tx, antidote.FlagPut(key, value))
+	case "map get":
+		val, err := bucket.ReadMap(tx, key)
+		if err != nil {
+			return nil, err
+		}
+		return val.ToGo(), nil
+	case "map update":
+		update, err := MapUpdate(key, o.Updates)
+		if err != nil {
+			return nil, err
+		}
+		return nil, bucket.Update(tx, update)
+	}
+	return nil, invalid("unknown operation %q on %q", o.Op, o.Type)
+}
+
+// Builds the update of a map from the updates of its entries
+func MapUpdate(key antidote.Key, updates []MapOperation) (*antidote.CRDTUpdate, error) {
+	if len(updates) == 0 {
+		return nil, invalid("expected updates")
+	}
+	b := antidote.NewMapUpdateBuilder(key)
+	for _, u := range updates {
+		switch u.Op {
+		case "put", "mvput":
+			value, err := stringValue(u.Value)
+			if err != nil {
+				return nil, err
+			}
+			if u.Op == "put" {
+				b.Put(u.Path, []byte(value))
+			} else {
+				b.MVPut(u.Path, []byte(value))
+			}
+		case "inc":
+			b.Inc(u.Path, amount(u.Amount))
+		case "add", "remove-elems":
+			if len(u.Elems) == 0 {
+				return nil, invalid("expected elements")
+			}
+			if u.Op == "add" {
+				b.Add(u.Path, toBytes(u.Elems)...)
+			} else {
+				b.RemoveElems(u.Path, toBytes(u.Elems)...)
+			}
+		case "flag":
+			value, err := boolValue(u.Value)
+			if err != nil {
+				return nil, err
+			}
+			b.Flag(u.Path, value)
+		case "remove":
+			types := make([]antidote.CRDTType, len(u.Types))
+			for i, v := range u.Types {
+				t, ok := antidote.CRDTType_value[strings.ToUpper(v)]
+				if !ok {
+					return nil, invalid("unknown type %q", v)
+				}
+				types[i] = antidote.CRDTType(t)
+			}
+			b.Remove(u.Path, types...)
+		default:
+			return nil, invalid("unknown map operation %q", u.Op)
+		}
+	}
+	update, err := b.Build()
+	if err != nil {
+		return nil, invalid("%v", err)
+	}
+	return update, nil
+}
+
+func amount(a *int64) int64 {
+	if a == nil {
+		return 1
+	}
+	return *a
+}
+
+func stringValue(v interface{}) (string, error) {
+	value, ok := v.(string)
+	if !ok {
+		return "", invalid("expected a string value")
+	}
+	return value, nil
+}
+
+func boolValue(v interface{}) (bool, error) {
+	switch value := v.(type) {
+	case bool:
+		return value, nil
+	case string:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b, nil
+		}
+	}
+	return false, invalid("expected a boolean value")
+}
+
+func toStrings(values [][]byte) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = string(v)
+	}
+	return res
+}
+
+func toBytes(values []string) [][]byte {
+	res := make([][]byte, len(values))
+	for i, v := range values {
+		res[i] = []byte(v)
+	}
+	return res
+}
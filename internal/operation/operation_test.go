@@ -0,0 +1,42 @@
+package operation
+
+import (
+	"errors"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMapUpdate(t *testing.T) {
+	inc := int64(2)
+	update, err := MapUpdate(antidote.Key("m"), []MapOperation{
+		{Op: "put", Path: "name", Value: "x"},
+		{Op: "inc", Path: "visits", Amount: &inc},
+		{Op: "flag", Path: "active", Value: "true"},
+		{Op: "flag", Path: "admin", Value: false},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := antidote.NewMapUpdateBuilder(antidote.Key("m")).
+		Put("name", []byte("x")).Inc("visits", 2).Flag("active", true).Flag("admin", false).Build()
+	if !proto.Equal(update.Update, expected.Update) {
+		t.Fatalf("unexpected update %v", update.Update)
+	}
+
+	for _, invalid := range [][]MapOperation{
+		nil,
+		{{Op: "put", Path: "name"}},
+		{{Op: "put", Path: "name", Value: true}},
+		{{Op: "flag", Path: "active", Value: "yes"}},
+		{{Op: "add", Path: "tags"}},
+		{{Op: "remove", Path: "x", Types: []string{"nope"}}},
+		{{Op: "append", Path: "x"}},
+	} {
+		var ierr *InvalidError
+		if _, err := MapUpdate(antidote.Key("m"), invalid); !errors.As(err, &ierr) {
+			t.Fatalf("%v: expected invalid operation, got %v", invalid, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package antidoteclient
+
+import (
+	"context"
+	"encoding/hex"
+	"log/slog"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Selects which parts of protocol-buffer messages are hidden in debug logs
+type Redaction int
+
+const (
+	// Hide keys of objects and map entries
+	RedactKeys Redaction = 1 << iota
+	// Hide values of registers, sets and flags in updates and read results
+	RedactValues
+)
+
+// Replacement of redacted keys and values
+const redacted = "<redacted>"
+
+// Names of bytes fields holding keys of objects and map entries
+var keyFields = map[protoreflect.Name]bool{"key": true}
+
+// Names of bytes fields holding values of objects
+var valueFields = map[protoreflect.Name]bool{"value": true, "values": true, "adds": true, "rems": true}
+
+func (client *Client) logEnabled(level slog.Level) bool {
+	return client.logger != nil && client.logger.Enabled(context.Background(), level)
+}
+
+// Logs a message at debug level, if a logger is configured
+func (client *Client) debug(msg string, args ...interface{}) {
+	if client.logEnabled(slog.LevelDebug) {
+		client.logger.Debug(msg, args...)
+	}
+}
+
+// Logs a message at warn level, if a logger is configured
+func (client *Client) warn(msg string, args ...interface{}) {
+	if client.logEnabled(slog.LevelWarn) {
+		client.logger.Warn(msg, args...)
+	}
+}
+
+// Logs a frame sent to or received from Antidote at debug level and records it.
+func (c *connection) logFrame(sent bool, code MessageCode, payload []byte) {
+	c.recordFrame(sent, code, payload)
+	client := c.client
+	if !client.logEnabled(slog.LevelDebug) {
+		return
+	}
+	direction := "received"
+	if sent {
+		direction = "sent"
+	}
+	args := []interface{}{
+		slog.String("host", c.host.host.String()),
+		slog.Int("code", int(code)),
+		slog.String("type", code.String()),
+		slog.Int("size", len(payload)+1),
+	}
+	if t, ok := lookupMessage(code); ok {
+		msg := t.new()
+		if err := proto.Unmarshal(payload, msg); err != nil {
+			args = append(args, slog.String("error", err.Error()))
+		} else {
+			if d, ok := msg.(interface{ GetTransactionDescriptor() []byte }); ok && d.GetTransactionDescriptor() != nil {
+				args = append(args, slog.String("tx", hex.EncodeToString(d.GetTransactionDescriptor())))
+			}
+			redact(msg.ProtoReflect(), client.redaction)
+			args = append(args, slog.String("message", prototext.MarshalOptions{}.Format(msg)))
+		}
+	}
+	client.logger.Debug("frame "+direction, args...)
+}
+
+// Replaces keys and values of the message and its nested messages according to the redaction.
+func redact(m protoreflect.Message, redaction Redaction) {
+	if redaction == 0 {
+		return
+	}
+	// collect fields first, the message must not be modified while ranging over it
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	for _, fd := range fields {
+		v := m.Get(fd)
+		switch {
+		case fd.Kind() == protoreflect.BytesKind:
+			if (redaction&RedactKeys != 0 && keyFields[fd.Name()]) || (redaction&RedactValues != 0 && valueFields[fd.Name()]) {
+				if fd.IsList() {
+					list := v.List()
+					for i := 0; i < list.Len(); i++ {
+						list.Set(i, protoreflect.ValueOfBytes([]byte(redacted)))
+					}
+				} else {
+					m.Set(fd, protoreflect.ValueOfBytes([]byte(redacted)))
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redact(list.Get(i).Message(), redaction)
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			redact(v.Message(), redaction)
+		case fd.Kind() == protoreflect.BoolKind && redaction&RedactValues != 0 && valueFields[fd.Name()]:
+			m.Clear(fd)
+		}
+	}
+}
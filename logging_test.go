@@ -0,0 +1,61 @@
+package antidoteclient
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type syncBuffer struct {
+	mutex sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.String()
+}
+
+func TestFrameLogging(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	for _, redaction := range []Redaction{0, RedactKeys | RedactValues} {
+		out := &syncBuffer{}
+		logger := slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client, err := NewClientWithOptions(ClientOptions{Logger: logger, Redaction: redaction}, server.host())
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := client.StartTransaction()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bucket := Bucket{[]byte("bucket")}
+		if err = bucket.Update(tx, RegPut(Key("secretkey"), []byte("secretvalue"))); err != nil {
+			t.Fatal(err)
+		}
+		if err = tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		client.Close()
+
+		log := out.String()
+		for _, expected := range []string{"type=ApbUpdateObjects", "type=ApbOperationResp", "tx=7478", "transaction committed"} {
+			if !strings.Contains(log, expected) {
+				t.Fatalf("expected %q in log:\n%s", expected, log)
+			}
+		}
+		hidden := redaction != 0
+		if strings.Contains(log, "secretkey") == hidden || strings.Contains(log, "secretvalue") == hidden {
+			t.Fatalf("redaction %d not applied correctly:\n%s", redaction, log)
+		}
+	}
+}
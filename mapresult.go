@@ -0,0 +1,197 @@
+package antidoteclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Represents the nested objects stored under a single key of a map.
+// Antidote maps are keyed by key and type, so a key may hold objects of several CRDT types.
+type MapValue struct {
+	path    []string
+	entries []*ApbMapEntry
+}
+
+// Navigates nested maps along the given path and returns the objects stored under the last segment.
+// All segments but the last one have to refer to nested maps.
+//
+//	city, err := mrr.Get("address", "city")
+//	val, err := city.Reg()
+func (mrr *MapReadResult) Get(path ...string) (val *MapValue, err error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty map path")
+	}
+	current := mrr
+	for i, segment := range path[:len(path)-1] {
+		current, err = current.nestedMap(Key(segment))
+		if err != nil {
+			return nil, fmt.Errorf("map entry with path '%s' not found", strings.Join(path[:i+1], "/"))
+		}
+	}
+	entries := current.entries(Key(path[len(path)-1]))
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("map entry with path '%s' not found", strings.Join(path, "/"))
+	}
+	return &MapValue{path: path, entries: entries}, nil
+}
+
+// Navigates nested maps along the given path where every segment states the key and the type of the entry.
+// All segments but the last one have to refer to maps.
+func (mrr *MapReadResult) GetTyped(path ...MapEntryKey) (val *ApbReadObjectResp, err error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty map path")
+	}
+	current := mrr
+	for i, segment := range path {
+		me := current.entry(segment.Key, segment.CrdtType)
+		if me == nil {
+			return nil, fmt.Errorf("%s entry with key '%s' not found at depth %d", segment.CrdtType, segment.Key, i)
+		}
+		if i == len(path)-1 {
+			return me.Value, nil
+		}
+		if me.Value.Map == nil {
+			return nil, fmt.Errorf("%s entry with key '%s' is not a map", segment.CrdtType, segment.Key)
+		}
+		current = newMapReadResult(me.Value.Map)
+	}
+	return
+}
+
+// Types of nested maps, add-wins maps preferred over grow-only maps
+var mapTypes = []CRDTType{CRDTType_RRMAP, CRDTType_GMAP}
+
+// Looks up a nested map, preferring add-wins maps over grow-only maps.
+func (mrr *MapReadResult) nestedMap(key Key) (val *MapReadResult, err error) {
+	for _, crdtType := range mapTypes {
+		if me := mrr.entry(key, crdtType); me != nil && me.Value.Map != nil {
+			return newMapReadResult(me.Value.Map), nil
+		}
+	}
+	return nil, fmt.Errorf("map entry with key '%s' not found", key)
+}
+
+func (v *MapValue) find(crdtType CRDTType) *ApbReadObjectResp {
+	for _, me := range v.entries {
+		if *me.Key.Type == crdtType {
+			return me.Value
+		}
+	}
+	return nil
+}
+
+func (v *MapValue) notFound(kind string) error {
+	return fmt.Errorf("%s entry with path '%s' not found", kind, strings.Join(v.path, "/"))
+}
+
+// Lists the CRDT types of the objects stored under the key
+func (v *MapValue) Types() []CRDTType {
+	types := make([]CRDTType, len(v.entries))
+	for i, me := range v.entries {
+		types[i] = *me.Key.Type
+	}
+	return types
+}
+
+// Access the value of the add-wins set stored under the key
+func (v *MapValue) Set() (val [][]byte, err error) {
+	if r := v.find(CRDTType_ORSET); r != nil {
+		return r.Set.Value, nil
+	}
+	return nil, v.notFound("set")
+}
+
+// Access the value of the last-writer-wins register stored under the key
+func (v *MapValue) Reg() (val []byte, err error) {
+	if r := v.find(CRDTType_LWWREG); r != nil {
+		return r.Reg.Value, nil
+	}
+	return nil, v.notFound("register")
+}
+
+// Access the value of the multi-value register stored under the key
+func (v *MapValue) MVReg() (val [][]byte, err error) {
+	if r := v.find(CRDTType_MVREG); r != nil {
+		return r.Mvreg.Values, nil
+	}
+	return nil, v.notFound("multi-value register")
+}
+
+// Access the value of the counter stored under the key
+func (v *MapValue) Counter() (val int32, err error) {
+	if r := v.find(CRDTType_COUNTER); r != nil {
+		return *r.Counter.Value, nil
+	}
+	return 0, v.notFound("counter")
+}
+
+// Access the value of the enable-wins flag stored under the key
+func (v *MapValue) Flag() (val bool, err error) {
+	if r := v.find(CRDTType_FLAG_EW); r != nil {
+		return *r.Flag.Value, nil
+	}
+	return false, v.notFound("flag")
+}
+
+// Access the value of the map stored under the key, preferring add-wins maps over grow-only maps like Get
+func (v *MapValue) Map() (val *MapReadResult, err error) {
+	for _, crdtType := range mapTypes {
+		if r := v.find(crdtType); r != nil && r.Map != nil {
+			return newMapReadResult(r.Map), nil
+		}
+	}
+	return nil, v.notFound("map")
+}
+
+// Converts the whole map, including nested maps, into plain Go values suitable for JSON encoding.
+// Counters become int32, registers strings, sets and multi-value registers string slices,
+// flags booleans and nested maps map[string]interface{}.
+// The conversion of register and set values to strings is lossy for binary data:
+// invalid UTF-8 is replaced when the result is encoded as JSON. Use Get or GetTyped to access the raw bytes.
+// If a key holds objects of several types, each object is stored under "key:TYPE", e.g. "visits:COUNTER".
+func (mrr *MapReadResult) ToGo() map[string]interface{} {
+	return mapToGo(mrr.mapResp)
+}
+
+func mapToGo(mapResp *ApbGetMapResp) map[string]interface{} {
+	keyCount := make(map[string]int, len(mapResp.Entries))
+	for _, me := range mapResp.Entries {
+		keyCount[string(me.Key.Key)]++
+	}
+	res := make(map[string]interface{}, len(mapResp.Entries))
+	for _, me := range mapResp.Entries {
+		k := string(me.Key.Key)
+		if keyCount[k] > 1 {
+			k = fmt.Sprintf("%s:%s", k, *me.Key.Type)
+		}
+		res[k] = readObjectToGo(me.Value)
+	}
+	return res
+}
+
+// Converts the value of a single object into a plain Go value, see MapReadResult.ToGo.
+func readObjectToGo(obj *ApbReadObjectResp) interface{} {
+	switch {
+	case obj.Counter != nil:
+		return obj.Counter.GetValue()
+	case obj.Set != nil:
+		return bytesToStrings(obj.Set.Value)
+	case obj.Reg != nil:
+		return string(obj.Reg.Value)
+	case obj.Mvreg != nil:
+		return bytesToStrings(obj.Mvreg.Values)
+	case obj.Map != nil:
+		return mapToGo(obj.Map)
+	case obj.Flag != nil:
+		return obj.Flag.GetValue()
+	}
+	return nil
+}
+
+func bytesToStrings(values [][]byte) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = string(v)
+	}
+	return res
+}
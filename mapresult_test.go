@@ -0,0 +1,102 @@
+package antidoteclient
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func mapEntry(key string, crdtType CRDTType, value *ApbReadObjectResp) *ApbMapEntry {
+	return &ApbMapEntry{Key: &ApbMapKey{Key: []byte(key), Type: &crdtType}, Value: value}
+}
+
+func testMapReadResult() *MapReadResult {
+	visits := int32(3)
+	enabled := true
+	address := &ApbGetMapResp{Entries: []*ApbMapEntry{
+		mapEntry("city", CRDTType_LWWREG, &ApbReadObjectResp{Reg: &ApbGetRegResp{Value: []byte("Kaiserslautern")}}),
+		mapEntry("zip", CRDTType_MVREG, &ApbReadObjectResp{Mvreg: &ApbGetMVRegResp{Values: [][]byte{[]byte("67663")}}}),
+	}}
+	return newMapReadResult(&ApbGetMapResp{Entries: []*ApbMapEntry{
+		mapEntry("address", CRDTType_RRMAP, &ApbReadObjectResp{Map: address}),
+		mapEntry("visits", CRDTType_COUNTER, &ApbReadObjectResp{Counter: &ApbGetCounterResp{Value: &visits}}),
+		mapEntry("visits", CRDTType_ORSET, &ApbReadObjectResp{Set: &ApbGetSetResp{Value: [][]byte{[]byte("a"), []byte("b")}}}),
+		mapEntry("enabled", CRDTType_FLAG_EW, &ApbReadObjectResp{Flag: &ApbGetFlagResp{Value: &enabled}}),
+	}})
+}
+
+func TestMapReadResultGetPath(t *testing.T) {
+	mrr := testMapReadResult()
+
+	city, err := mrr.Get("address", "city")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, e := city.Reg(); e != nil || string(v) != "Kaiserslautern" {
+		t.Fatalf("Wrong reg value: %s (%v)", v, e)
+	}
+	if _, e := city.Counter(); e == nil {
+		t.Fatal("expected error when reading register as counter")
+	}
+
+	visits, err := mrr.Get("visits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visits.Types()) != 2 {
+		t.Fatalf("expected two types under key visits, got %v", visits.Types())
+	}
+	if v, e := visits.Counter(); e != nil || v != 3 {
+		t.Fatalf("Wrong counter value: %d (%v)", v, e)
+	}
+
+	if _, e := mrr.Get("address", "street"); e == nil {
+		t.Fatal("expected error for missing entry")
+	}
+	if _, e := mrr.Get("visits", "x"); e == nil {
+		t.Fatal("expected error when navigating into a non-map entry")
+	}
+
+	zip, err := mrr.GetTyped(MapEntryKey{[]byte("address"), CRDTType_RRMAP}, MapEntryKey{[]byte("zip"), CRDTType_MVREG})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zip.Mvreg.Values) != 1 || string(zip.Mvreg.Values[0]) != "67663" {
+		t.Fatalf("Wrong mv-reg value: %s", zip.Mvreg.Values)
+	}
+	if _, e := mrr.GetTyped(MapEntryKey{[]byte("address"), CRDTType_GMAP}); e == nil {
+		t.Fatal("expected error for wrong segment type")
+	}
+}
+
+func TestMapValueGrowOnlyMap(t *testing.T) {
+	mrr := newMapReadResult(&ApbGetMapResp{Entries: []*ApbMapEntry{
+		mapEntry("settings", CRDTType_GMAP, &ApbReadObjectResp{Map: &ApbGetMapResp{Entries: []*ApbMapEntry{
+			mapEntry("theme", CRDTType_LWWREG, &ApbReadObjectResp{Reg: &ApbGetRegResp{Value: []byte("dark")}}),
+		}}}),
+	}})
+	settings, err := mrr.Get("settings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := settings.Map()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, e := m.Reg(Key("theme")); e != nil || string(v) != "dark" {
+		t.Fatalf("Wrong reg value: %s (%v)", v, e)
+	}
+	if theme, e := mrr.Get("settings", "theme"); e != nil || theme.Types()[0] != CRDTType_LWWREG {
+		t.Fatalf("expected path through grow-only map (%v)", e)
+	}
+}
+
+func TestMapReadResultToGo(t *testing.T) {
+	data, err := json.Marshal(testMapReadResult().ToGo())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"address":{"city":"Kaiserslautern","zip":["67663"]},"enabled":true,"visits:COUNTER":3,"visits:ORSET":["a","b"]}`
+	if string(data) != expected {
+		t.Fatalf("unexpected JSON:\n%s\nexpected:\n%s", data, expected)
+	}
+}
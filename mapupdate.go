@@ -0,0 +1,325 @@
+package antidoteclient
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Separator of the segments of paths used by MapUpdateBuilder
+const MapPathSeparator = "/"
+
+// Builds a single update of an add-wins map from operations addressed by paths into nested maps.
+// Operations on the same nested map are merged into one nested update,
+// counter increments on the same key are summed up, set operations of the same kind are combined
+// and only the last write to a register or flag is kept.
+//
+//	b := NewMapUpdateBuilder(Key("user"))
+//	b.Put("address/city", []byte("Kaiserslautern"))
+//	b.Inc("stats/logins", 1)
+//	b.Remove("address/old")
+//	update, err := b.Build()
+//	err = bucket.Update(tx, update)
+//
+// Within one map update Antidote applies removals after the updates,
+// therefore a removal discards earlier operations on the same key and updating a key after removing it is an error.
+type MapUpdateBuilder struct {
+	key  Key
+	root *mapUpdateNode
+	err  error
+}
+
+type mapEntryID struct {
+	key      string
+	crdtType CRDTType
+}
+
+// Collects the merged updates and removals of one (nested) map
+type mapUpdateNode struct {
+	order   []mapEntryID
+	entries map[mapEntryID]*mapUpdateEntry
+	removed []mapEntryID
+}
+
+type mapUpdateEntry struct {
+	ops    []*ApbUpdateOperation
+	nested *mapUpdateNode
+}
+
+// Types that are removed by MapUpdateBuilder.Remove when no type is given
+var removableTypes = []CRDTType{CRDTType_COUNTER, CRDTType_ORSET, CRDTType_LWWREG, CRDTType_MVREG, CRDTType_RRMAP, CRDTType_FLAG_EW}
+
+// Creates a builder for updates of the add-wins map with the given key
+func NewMapUpdateBuilder(key Key) *MapUpdateBuilder {
+	return &MapUpdateBuilder{key: key, root: newMapUpdateNode()}
+}
+
+func newMapUpdateNode() *mapUpdateNode {
+	return &mapUpdateNode{entries: make(map[mapEntryID]*mapUpdateEntry)}
+}
+
+// Writes a value into the last-writer-wins register at the given path
+func (b *MapUpdateBuilder) Put(path string, value []byte) *MapUpdateBuilder {
+	return b.apply(path, func(key Key) *CRDTUpdate { return RegPut(key, value) })
+}
+
+// Writes a value into the multi-value register at the given path
+func (b *MapUpdateBuilder) MVPut(path string, value []byte) *MapUpdateBuilder {
+	return b.apply(path, func(key Key) *CRDTUpdate { return MVRegPut(key, value) })
+}
+
+// Increments the counter at the given path
+func (b *MapUpdateBuilder) Inc(path string, inc int64) *MapUpdateBuilder {
+	return b.apply(path, func(key Key) *CRDTUpdate { return CounterInc(key, inc) })
+}
+
+// Adds elements to the add-wins set at the given path
+func (b *MapUpdateBuilder) Add(path string, elems ...[]byte) *MapUpdateBuilder {
+	return b.apply(path, func(key Key) *CRDTUpdate { return SetAdd(key, elems...) })
+}
+
+// Removes elements from the add-wins set at the given path
+func (b *MapUpdateBuilder) RemoveElems(path string, elems ...[]byte) *MapUpdateBuilder {
+	return b.apply(path, func(key Key) *CRDTUpdate { return SetRemove(key, elems...) })
+}
+
+// Enables or disables the flag at the given path
+func (b *MapUpdateBuilder) Flag(path string, value bool) *MapUpdateBuilder {
+	return b.apply(path, func(key Key) *CRDTUpdate { return FlagPut(key, value) })
+}
+
+// Applies an update to the map at the given path.
+// The key of the update is taken relative to that map; an empty path refers to the map of the builder itself.
+func (b *MapUpdateBuilder) Update(path string, update *CRDTUpdate) *MapUpdateBuilder {
+	if b.err != nil {
+		return b
+	}
+	node := b.root
+	if path != "" {
+		segments, err := splitMapPath(path)
+		if err != nil {
+			b.err = err
+			return b
+		}
+		node, err = b.descend(segments)
+		if err != nil {
+			b.err = err
+			return b
+		}
+	}
+	b.err = node.merge(string(update.Key), update.Type, update.Update)
+	return b
+}
+
+// Removes the objects at the given path from their map.
+// Without types, objects of all types the client can create are removed.
+func (b *MapUpdateBuilder) Remove(path string, types ...CRDTType) *MapUpdateBuilder {
+	if b.err != nil {
+		return b
+	}
+	segments, err := splitMapPath(path)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	node, err := b.descend(segments[:len(segments)-1])
+	if err != nil {
+		b.err = err
+		return b
+	}
+	if len(types) == 0 {
+		types = removableTypes
+	}
+	for _, t := range types {
+		node.remove(mapEntryID{segments[len(segments)-1], t})
+	}
+	return b
+}
+
+// Builds the update of the map.
+// The update is a copy, operations added to the builder afterwards do not change it.
+// Returns the first error encountered while adding operations to the builder.
+func (b *MapUpdateBuilder) Build() (*CRDTUpdate, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	// the merged operations are changed in place by further operations on the same objects
+	mapop := proto.Clone(b.root.build()).(*ApbMapUpdate)
+	return &CRDTUpdate{
+		Key:    b.key,
+		Type:   CRDTType_RRMAP,
+		Update: &ApbUpdateOperation{Mapop: mapop},
+	}, nil
+}
+
+// Adds the update created by newUpdate for the last path segment to the map denoted by the other segments.
+func (b *MapUpdateBuilder) apply(path string, newUpdate func(key Key) *CRDTUpdate) *MapUpdateBuilder {
+	if b.err != nil {
+		return b
+	}
+	segments, err := splitMapPath(path)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	node, err := b.descend(segments[:len(segments)-1])
+	if err != nil {
+		b.err = err
+		return b
+	}
+	update := newUpdate(Key(segments[len(segments)-1]))
+	b.err = node.merge(string(update.Key), update.Type, update.Update)
+	return b
+}
+
+// Returns the node of the nested map with the given path, creating nodes as needed.
+func (b *MapUpdateBuilder) descend(segments []string) (node *mapUpdateNode, err error) {
+	node = b.root
+	for i, segment := range segments {
+		entry, err := node.entry(mapEntryID{segment, CRDTType_RRMAP})
+		if err != nil {
+			return nil, fmt.Errorf("%s at path '%s'", err, strings.Join(segments[:i+1], MapPathSeparator))
+		}
+		if entry.nested == nil {
+			entry.nested = newMapUpdateNode()
+		}
+		node = entry.nested
+	}
+	return node, nil
+}
+
+func splitMapPath(path string) ([]string, error) {
+	segments := strings.Split(path, MapPathSeparator)
+	for _, s := range segments {
+		if s == "" {
+			return nil, fmt.Errorf("invalid map path '%s': empty segment", path)
+		}
+	}
+	return segments, nil
+}
+
+func (node *mapUpdateNode) isRemoved(id mapEntryID) bool {
+	for _, r := range node.removed {
+		if r == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the entry with the given id, creating it if necessary.
+func (node *mapUpdateNode) entry(id mapEntryID) (*mapUpdateEntry, error) {
+	if node.isRemoved(id) {
+		return nil, fmt.Errorf("cannot update %s entry '%s' after removing it", id.crdtType, id.key)
+	}
+	entry, ok := node.entries[id]
+	if !ok {
+		entry = &mapUpdateEntry{}
+		node.entries[id] = entry
+		node.order = append(node.order, id)
+	}
+	return entry, nil
+}
+
+func (node *mapUpdateNode) remove(id mapEntryID) {
+	if _, ok := node.entries[id]; ok {
+		delete(node.entries, id)
+		for i, o := range node.order {
+			if o == id {
+				node.order = append(node.order[:i], node.order[i+1:]...)
+				break
+			}
+		}
+	}
+	if !node.isRemoved(id) {
+		node.removed = append(node.removed, id)
+	}
+}
+
+// Reports whether merging the operation would update an entry removed by the operations merged so far.
+func (node *mapUpdateNode) conflicts(id mapEntryID, op *ApbUpdateOperation) bool {
+	if node.isRemoved(id) {
+		return true
+	}
+	entry, ok := node.entries[id]
+	if !ok || entry.nested == nil || op.Mapop == nil {
+		return false
+	}
+	for _, nu := range op.Mapop.Updates {
+		if entry.nested.conflicts(mapEntryID{string(nu.Key.Key), nu.Key.GetType()}, nu.Update) {
+			return true
+		}
+	}
+	return false
+}
+
+// Merges the operation on the object with the given key and type into the node.
+func (node *mapUpdateNode) merge(key string, crdtType CRDTType, op *ApbUpdateOperation) error {
+	entry, err := node.entry(mapEntryID{key, crdtType})
+	if err != nil {
+		return err
+	}
+	if op.Mapop != nil {
+		if entry.nested == nil {
+			entry.nested = newMapUpdateNode()
+		}
+		for _, nu := range op.Mapop.Updates {
+			if err := entry.nested.merge(string(nu.Key.Key), *nu.Key.Type, nu.Update); err != nil {
+				return err
+			}
+		}
+		for _, rk := range op.Mapop.RemovedKeys {
+			entry.nested.remove(mapEntryID{string(rk.Key), *rk.Type})
+		}
+		return nil
+	}
+	op = proto.Clone(op).(*ApbUpdateOperation)
+	if len(entry.ops) == 0 {
+		entry.ops = []*ApbUpdateOperation{op}
+		return nil
+	}
+	last := entry.ops[len(entry.ops)-1]
+	switch {
+	case op.Counterop != nil && last.Counterop != nil:
+		inc := last.Counterop.GetInc() + op.Counterop.GetInc()
+		last.Counterop.Inc = &inc
+	case op.Setop != nil && last.Setop != nil && op.Setop.GetOptype() == last.Setop.GetOptype():
+		last.Setop.Adds = append(last.Setop.Adds, op.Setop.Adds...)
+		last.Setop.Rems = append(last.Setop.Rems, op.Setop.Rems...)
+	case op.Regop != nil || op.Flagop != nil:
+		entry.ops = []*ApbUpdateOperation{op}
+	default:
+		entry.ops = append(entry.ops, op)
+	}
+	return nil
+}
+
+// Calls f for every merged operation, in the order the objects were first updated.
+func (node *mapUpdateNode) each(f func(id mapEntryID, op *ApbUpdateOperation)) {
+	for _, id := range node.order {
+		entry := node.entries[id]
+		for _, op := range entry.ops {
+			f(id, op)
+		}
+		if entry.nested != nil {
+			f(id, &ApbUpdateOperation{Mapop: entry.nested.build()})
+		}
+	}
+}
+
+func (node *mapUpdateNode) build() *ApbMapUpdate {
+	mapop := &ApbMapUpdate{}
+	node.each(func(id mapEntryID, op *ApbUpdateOperation) {
+		crdtType := id.crdtType
+		mapop.Updates = append(mapop.Updates, &ApbMapNestedUpdate{
+			Key:    &ApbMapKey{Key: []byte(id.key), Type: &crdtType},
+			Update: op,
+		})
+	})
+	for _, id := range node.removed {
+		crdtType := id.crdtType
+		mapop.RemovedKeys = append(mapop.RemovedKeys, &ApbMapKey{Key: []byte(id.key), Type: &crdtType})
+	}
+	return mapop
+}
@@ -0,0 +1,95 @@
+package antidoteclient
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMapUpdateBuilderMerge(t *testing.T) {
+	update, err := NewMapUpdateBuilder(Key("user")).
+		Put("address/city", []byte("Berlin")).
+		Inc("stats/logins", 1).
+		Put("address/city", []byte("Kaiserslautern")).
+		Inc("stats/logins", 2).
+		Add("tags", []byte("a")).
+		Add("tags", []byte("b")).
+		Remove("address/old", CRDTType_LWWREG).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := MapUpdate(Key("user"),
+		MapUpdate(Key("address"), RegPut(Key("city"), []byte("Kaiserslautern"))),
+		MapUpdate(Key("stats"), CounterInc(Key("logins"), 3)),
+		SetAdd(Key("tags"), []byte("a"), []byte("b")))
+	old := expected.Update.Mapop.Updates[0].Update.Mapop
+	old.RemovedKeys = []*ApbMapKey{{Key: []byte("old"), Type: CRDTType_LWWREG.Enum()}}
+
+	if update.Key == nil || string(update.Key) != "user" || update.Type != CRDTType_RRMAP {
+		t.Fatalf("wrong top-level key or type: %s %s", update.Key, update.Type)
+	}
+	if !proto.Equal(update.Update, expected.Update) {
+		t.Fatalf("unexpected update:\n%v\nexpected:\n%v", update.Update, expected.Update)
+	}
+}
+
+func TestMapUpdateBuilderBuildCopies(t *testing.T) {
+	b := NewMapUpdateBuilder(Key("user")).Inc("stats/logins", 1).Add("tags", []byte("a"))
+	first, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := proto.Clone(first.Update)
+	if _, err = b.Inc("stats/logins", 2).Add("tags", []byte("b")).Build(); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(first.Update, expected) {
+		t.Fatalf("built update changed by the builder:\n%v\nexpected:\n%v", first.Update, expected)
+	}
+}
+
+func TestMapUpdateBuilderNestedUpdate(t *testing.T) {
+	update, err := NewMapUpdateBuilder(Key("m")).
+		Inc("a/count", 1).
+		Update("a", MapUpdate(Key("b"), RegPut(Key("c"), []byte("v")))).
+		Update("", MapUpdate(Key("a"), CounterInc(Key("count"), 4))).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := MapUpdate(Key("m"),
+		MapUpdate(Key("a"),
+			CounterInc(Key("count"), 5),
+			MapUpdate(Key("b"), RegPut(Key("c"), []byte("v")))))
+	if !proto.Equal(update.Update, expected.Update) {
+		t.Fatalf("unexpected update:\n%v\nexpected:\n%v", update.Update, expected.Update)
+	}
+}
+
+func TestMapUpdateBuilderRemove(t *testing.T) {
+	update, err := NewMapUpdateBuilder(Key("m")).
+		Put("a/x", []byte("v")).
+		Remove("a/x").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested := update.Update.Mapop.Updates[0].Update.Mapop
+	if len(nested.Updates) != 0 {
+		t.Fatalf("update of removed key should be discarded: %v", nested.Updates)
+	}
+	if len(nested.RemovedKeys) != len(removableTypes) {
+		t.Fatalf("expected removal of all types, got %v", nested.RemovedKeys)
+	}
+
+	_, err = NewMapUpdateBuilder(Key("m")).Remove("a", CRDTType_RRMAP).Put("a/x", []byte("v")).Build()
+	if err == nil {
+		t.Fatal("expected error when updating a removed map")
+	}
+	_, err = NewMapUpdateBuilder(Key("m")).Put("a//x", []byte("v")).Build()
+	if err == nil {
+		t.Fatal("expected error for empty path segment")
+	}
+}
@@ -0,0 +1,47 @@
+package antidoteclient
+
+import "time"
+
+// Identifies an operation of the client in metrics
+type Operation string
+
+const (
+	OpStartTransaction Operation = "start_transaction"
+	OpRead             Operation = "read"
+	OpUpdate           Operation = "update"
+	OpCommit           Operation = "commit"
+	OpAbort            Operation = "abort"
+	OpStaticRead       Operation = "static_read"
+	OpStaticUpdate     Operation = "static_update"
+	// Cluster management operations
+	OpCreateDC                Operation = "create_dc"
+	OpGetConnectionDescriptor Operation = "get_connection_descriptor"
+	OpConnectToDCs            Operation = "connect_to_dcs"
+)
+
+// Receives measurements of a client.
+// Implementations have to be safe for concurrent use; package prommetrics offers an implementation.
+type Metrics interface {
+	// Called after every operation with its latency and its error, nil on success.
+	// Errors reported by Antidote are of type *ServerError and carry the error code.
+	// The host is empty if no connection could be obtained.
+	OperationDone(op Operation, host Host, duration time.Duration, err error)
+	// Called whenever a connection is taken from or returned to the pool of a host
+	PoolUsage(host Host, inUse int, idle int)
+	// Called with the number of bytes written to a connection to the host
+	BytesSent(host Host, n int)
+	// Called with the number of bytes read from a connection to the host
+	BytesReceived(host Host, n int)
+}
+
+// Reports an operation to the metrics of the client; con may be nil.
+func (client *Client) observe(op Operation, con *connection, start time.Time, err error) {
+	if client.metrics == nil {
+		return
+	}
+	var host Host
+	if con != nil {
+		host = con.host.host
+	}
+	client.metrics.OperationDone(op, host, time.Since(start), err)
+}
@@ -0,0 +1,98 @@
+package antidoteclient
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type recordingMetrics struct {
+	mutex    sync.Mutex
+	ops      []Operation
+	errs     []error
+	inUse    map[Host]int
+	sent     int
+	received int
+}
+
+func (m *recordingMetrics) OperationDone(op Operation, host Host, duration time.Duration, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.ops = append(m.ops, op)
+	m.errs = append(m.errs, err)
+}
+
+func (m *recordingMetrics) PoolUsage(host Host, inUse int, idle int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.inUse[host] = inUse
+}
+
+func (m *recordingMetrics) BytesSent(host Host, n int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.sent += n
+}
+
+func (m *recordingMetrics) BytesReceived(host Host, n int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.received += n
+}
+
+func TestMetrics(t *testing.T) {
+	server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		if code == MsgUpdateObjects {
+			success := false
+			errorcode := uint32(7)
+			return MsgOperationResp, &ApbOperationResp{Success: &success, Errorcode: &errorcode}
+		}
+		return ackHandler(code, data)
+	})
+	metrics := &recordingMetrics{inUse: make(map[Host]int)}
+	client, err := NewClientWithOptions(ClientOptions{Metrics: metrics}, server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	bucket := Bucket{[]byte("bucket")}
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.inUse[server.host()] != 1 {
+		t.Fatalf("expected one connection in use, got %d", metrics.inUse[server.host()])
+	}
+	err = bucket.Update(tx, CounterInc(Key("a"), 1))
+	if serr, ok := err.(*ServerError); !ok || serr.Code != 7 {
+		t.Fatalf("expected server error with code 7, got %v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err = bucket.Update(client.CreateStaticTransaction(), CounterInc(Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Operation{OpStartTransaction, OpUpdate, OpCommit, OpStaticUpdate}
+	if len(metrics.ops) != len(expected) {
+		t.Fatalf("unexpected operations: %v", metrics.ops)
+	}
+	for i, op := range expected {
+		if metrics.ops[i] != op {
+			t.Fatalf("unexpected operations: %v", metrics.ops)
+		}
+		if (metrics.errs[i] != nil) != (op == OpUpdate) {
+			t.Fatalf("unexpected error for %s: %v", op, metrics.errs[i])
+		}
+	}
+	if metrics.inUse[server.host()] != 0 {
+		t.Fatalf("expected all connections to be returned, got %d", metrics.inUse[server.host()])
+	}
+	if metrics.sent == 0 || metrics.received == 0 {
+		t.Fatalf("traffic not recorded: sent %d, received %d", metrics.sent, metrics.received)
+	}
+}
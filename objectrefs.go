@@ -0,0 +1,145 @@
+package antidoteclient
+
+// Object references bundle a bucket, a key and the CRDT type of an object.
+// They allow to read and update the same object without repeating the key and type at every call site.
+// References do not communicate with the Antidote server until a read or update is issued in the context of a transaction.
+
+// Reference to a counter identified by a key in a bucket.
+type CounterRef struct {
+	bucket *Bucket
+	key    Key
+}
+
+// Reference to an add-wins set identified by a key in a bucket.
+type SetRef struct {
+	bucket *Bucket
+	key    Key
+}
+
+// Reference to a last-writer-wins register identified by a key in a bucket.
+type RegRef struct {
+	bucket *Bucket
+	key    Key
+}
+
+// Reference to an add-wins map identified by a key in a bucket.
+type MapRef struct {
+	bucket *Bucket
+	key    Key
+}
+
+// Reference to an enable-wins flag identified by a key in a bucket.
+type FlagRef struct {
+	bucket *Bucket
+	key    Key
+}
+
+// Creates a reference to the counter with the given key in this bucket
+func (bucket *Bucket) Counter(key Key) *CounterRef {
+	return &CounterRef{bucket: bucket, key: key}
+}
+
+// Creates a reference to the add-wins set with the given key in this bucket
+func (bucket *Bucket) Set(key Key) *SetRef {
+	return &SetRef{bucket: bucket, key: key}
+}
+
+// Creates a reference to the last-writer-wins register with the given key in this bucket
+func (bucket *Bucket) Reg(key Key) *RegRef {
+	return &RegRef{bucket: bucket, key: key}
+}
+
+// Creates a reference to the add-wins map with the given key in this bucket
+func (bucket *Bucket) Map(key Key) *MapRef {
+	return &MapRef{bucket: bucket, key: key}
+}
+
+// Creates a reference to the enable-wins flag with the given key in this bucket
+func (bucket *Bucket) Flag(key Key) *FlagRef {
+	return &FlagRef{bucket: bucket, key: key}
+}
+
+// The key of the referenced counter
+func (ref *CounterRef) Key() Key {
+	return ref.key
+}
+
+// Increments the counter by the given value
+func (ref *CounterRef) Inc(tx Transaction, inc int64) error {
+	return ref.bucket.Update(tx, CounterInc(ref.key, inc))
+}
+
+// Reads the value of the counter
+func (ref *CounterRef) Get(tx Transaction) (val int32, err error) {
+	return ref.bucket.ReadCounter(tx, ref.key)
+}
+
+// The key of the referenced set
+func (ref *SetRef) Key() Key {
+	return ref.key
+}
+
+// Adds the given elements to the set
+func (ref *SetRef) Add(tx Transaction, elems ...[]byte) error {
+	return ref.bucket.Update(tx, SetAdd(ref.key, elems...))
+}
+
+// Removes the given elements from the set
+func (ref *SetRef) Remove(tx Transaction, elems ...[]byte) error {
+	return ref.bucket.Update(tx, SetRemove(ref.key, elems...))
+}
+
+// Reads the elements of the set
+func (ref *SetRef) Get(tx Transaction) (val [][]byte, err error) {
+	return ref.bucket.ReadSet(tx, ref.key)
+}
+
+// The key of the referenced register
+func (ref *RegRef) Key() Key {
+	return ref.key
+}
+
+// Writes the given value into the register
+func (ref *RegRef) Put(tx Transaction, value []byte) error {
+	return ref.bucket.Update(tx, RegPut(ref.key, value))
+}
+
+// Reads the value of the register
+func (ref *RegRef) Get(tx Transaction) (val []byte, err error) {
+	return ref.bucket.ReadReg(tx, ref.key)
+}
+
+// The key of the referenced map
+func (ref *MapRef) Key() Key {
+	return ref.key
+}
+
+// Applies the given updates to the nested objects of the map
+func (ref *MapRef) Update(tx Transaction, updates ...*CRDTUpdate) error {
+	return ref.bucket.Update(tx, MapUpdate(ref.key, updates...))
+}
+
+// Removes the nested objects with the given keys and types from the map
+func (ref *MapRef) Remove(tx Transaction, keys ...MapEntryKey) error {
+	return ref.bucket.Update(tx, MapRemove(ref.key, keys...))
+}
+
+// Reads the value of the map
+func (ref *MapRef) Get(tx Transaction) (val *MapReadResult, err error) {
+	return ref.bucket.ReadMap(tx, ref.key)
+}
+
+// The key of the referenced flag
+func (ref *FlagRef) Key() Key {
+	return ref.key
+}
+
+// Enables or disables the flag
+func (ref *FlagRef) Put(tx Transaction, value bool) error {
+	return ref.bucket.Update(tx, FlagPut(ref.key, value))
+}
+
+// Reads the value of the flag
+func (ref *FlagRef) Get(tx Transaction) (val bool, err error) {
+	return ref.bucket.ReadFlag(tx, ref.key)
+}
@@ -0,0 +1,100 @@
+// Package oteltrace records interactive transactions of an Antidote client as OpenTelemetry spans.
+//
+// Each transaction is recorded as a span from its start to its commit or abort,
+// each read and update as a child span of the transaction.
+//
+//	tracer := oteltrace.New(otel.GetTracerProvider())
+//	client, err := antidote.NewClientWithOptions(antidote.ClientOptions{Tracer: tracer}, hosts...)
+//	tx, err := client.StartTransactionContext(ctx)
+package oteltrace
+
+import (
+	"context"
+	"encoding/hex"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Name of the instrumentation scope
+const ScopeName = "github.com/AntidoteDB/antidote-go-client"
+
+// Attribute keys of the recorded spans
+const (
+	BucketsKey       = attribute.Key("antidote.buckets")
+	KeysKey          = attribute.Key("antidote.keys")
+	TypesKey         = attribute.Key("antidote.crdt_types")
+	HostKey          = attribute.Key("antidote.host")
+	TransactionIDKey = attribute.Key("antidote.transaction_id")
+	CommitTimeKey    = attribute.Key("antidote.commit_time")
+	// operation that ended the transaction: commit, abort or start_transaction if it could not be started
+	EndKey = attribute.Key("antidote.end")
+)
+
+// Implements antidote.Tracer using an OpenTelemetry tracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// Creates a tracer recording spans with the given provider
+func New(provider trace.TracerProvider) *Tracer {
+	return &Tracer{tracer: provider.Tracer(ScopeName)}
+}
+
+func (t *Tracer) StartTransaction(ctx context.Context) antidote.TransactionSpan {
+	ctx, span := t.tracer.Start(ctx, "antidote.transaction", trace.WithSpanKind(trace.SpanKindClient))
+	return &transactionSpan{tracer: t.tracer, ctx: ctx, span: span}
+}
+
+type transactionSpan struct {
+	tracer trace.Tracer
+	// context holding the transaction span, parent of the operation spans
+	ctx  context.Context
+	span trace.Span
+	host string
+}
+
+func (s *transactionSpan) Started(host antidote.Host, txID []byte) {
+	s.host = host.String()
+	s.span.SetAttributes(HostKey.String(s.host), TransactionIDKey.String(hex.EncodeToString(txID)))
+}
+
+func (s *transactionSpan) StartOperation(op antidote.Operation, objects []*antidote.ApbBoundObject) func(err error) {
+	buckets := make([]string, len(objects))
+	keys := make([]string, len(objects))
+	types := make([]string, len(objects))
+	for i, o := range objects {
+		buckets[i] = string(o.Bucket)
+		keys[i] = string(o.Key)
+		types[i] = o.GetType().String()
+	}
+	_, span := s.tracer.Start(s.ctx, "antidote."+string(op),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			HostKey.String(s.host),
+			BucketsKey.StringSlice(buckets),
+			KeysKey.StringSlice(keys),
+			TypesKey.StringSlice(types)))
+	return func(err error) {
+		setStatus(span, err)
+		span.End()
+	}
+}
+
+func (s *transactionSpan) End(op antidote.Operation, commitTime []byte, err error) {
+	s.span.SetAttributes(EndKey.String(string(op)))
+	if commitTime != nil {
+		s.span.SetAttributes(CommitTimeKey.String(hex.EncodeToString(commitTime)))
+	}
+	setStatus(s.span, err)
+	s.span.End()
+}
+
+func setStatus(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,66 @@
+package oteltrace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func attr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestTransactionSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := New(provider)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "request")
+	span := tracer.StartTransaction(ctx)
+	span.Started(antidote.Host{Name: "127.0.0.1", Port: 8087}, []byte{1, 2})
+	counter := antidote.CRDTType_COUNTER
+	end := span.StartOperation(antidote.OpRead, []*antidote.ApbBoundObject{{Bucket: []byte("b"), Key: []byte("k"), Type: &counter}})
+	end(nil)
+	end = span.StartOperation(antidote.OpUpdate, nil)
+	end(errors.New("failed"))
+	span.End(antidote.OpCommit, []byte{0xab}, nil)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans, got %d", len(spans))
+	}
+	read, update, tx := spans[0], spans[1], spans[2]
+	if read.Name() != "antidote.read" || update.Name() != "antidote.update" || tx.Name() != "antidote.transaction" {
+		t.Fatalf("unexpected span names: %s %s %s", read.Name(), update.Name(), tx.Name())
+	}
+	if read.Parent().SpanID() != tx.SpanContext().SpanID() || tx.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Fatal("unexpected span hierarchy")
+	}
+	if v := attr(read, KeysKey).AsStringSlice(); len(v) != 1 || v[0] != "k" {
+		t.Fatalf("unexpected keys attribute: %v", v)
+	}
+	if v := attr(read, TypesKey).AsStringSlice(); len(v) != 1 || v[0] != "COUNTER" {
+		t.Fatalf("unexpected types attribute: %v", v)
+	}
+	if v := attr(tx, HostKey).AsString(); v != "127.0.0.1:8087" {
+		t.Fatalf("unexpected host attribute: %s", v)
+	}
+	if v := attr(tx, CommitTimeKey).AsString(); v != "ab" {
+		t.Fatalf("unexpected commit time attribute: %s", v)
+	}
+	if update.Status().Code != codes.Error || read.Status().Code == codes.Error {
+		t.Fatalf("unexpected status: read %v, update %v", read.Status(), update.Status())
+	}
+}
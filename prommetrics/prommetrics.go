@@ -0,0 +1,116 @@
+// Package prommetrics records metrics of an Antidote client with Prometheus.
+//
+//	m := prommetrics.New("antidote")
+//	prometheus.MustRegister(m)
+//	client, err := antidote.NewClientWithOptions(antidote.ClientOptions{Metrics: m}, hosts...)
+package prommetrics
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Error code label of errors not reported by Antidote, e.g. network errors
+const ClientErrorCode = "client"
+
+// Implements antidote.Metrics and prometheus.Collector.
+type Metrics struct {
+	latency       *prometheus.HistogramVec
+	errors        *prometheus.CounterVec
+	poolInUse     *prometheus.GaugeVec
+	poolIdle      *prometheus.GaugeVec
+	bytesSent     *prometheus.CounterVec
+	bytesReceived *prometheus.CounterVec
+}
+
+// Creates metrics with the given namespace, register them with a prometheus.Registerer before use.
+func New(namespace string) *Metrics {
+	return &Metrics{
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of operations issued to Antidote.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"operation", "host"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "operation_errors_total",
+			Help:      "Failed operations by error code, \"" + ClientErrorCode + "\" for errors not reported by Antidote.",
+		}, []string{"operation", "code"}),
+		poolInUse: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "pool_connections_in_use",
+			Help:      "Connections taken from the pool of a host.",
+		}, []string{"host"}),
+		poolIdle: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "pool_connections_idle",
+			Help:      "Idle connections in the pool of a host.",
+		}, []string{"host"}),
+		bytesSent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "sent_bytes_total",
+			Help:      "Bytes sent to a host.",
+		}, []string{"host"}),
+		bytesReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "received_bytes_total",
+			Help:      "Bytes received from a host.",
+		}, []string{"host"}),
+	}
+}
+
+func (m *Metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.latency, m.errors, m.poolInUse, m.poolIdle, m.bytesSent, m.bytesReceived}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range m.collectors() {
+		c.Describe(ch)
+	}
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range m.collectors() {
+		c.Collect(ch)
+	}
+}
+
+func (m *Metrics) OperationDone(op antidote.Operation, host antidote.Host, duration time.Duration, err error) {
+	m.latency.WithLabelValues(string(op), hostLabel(host)).Observe(duration.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(string(op), errorCode(err)).Inc()
+	}
+}
+
+func (m *Metrics) PoolUsage(host antidote.Host, inUse int, idle int) {
+	m.poolInUse.WithLabelValues(hostLabel(host)).Set(float64(inUse))
+	m.poolIdle.WithLabelValues(hostLabel(host)).Set(float64(idle))
+}
+
+func (m *Metrics) BytesSent(host antidote.Host, n int) {
+	m.bytesSent.WithLabelValues(hostLabel(host)).Add(float64(n))
+}
+
+func (m *Metrics) BytesReceived(host antidote.Host, n int) {
+	m.bytesReceived.WithLabelValues(hostLabel(host)).Add(float64(n))
+}
+
+func hostLabel(host antidote.Host) string {
+	if host.Name == "" {
+		return ""
+	}
+	return host.String()
+}
+
+func errorCode(err error) string {
+	var serverErr *antidote.ServerError
+	if errors.As(err, &serverErr) {
+		return strconv.FormatUint(uint64(serverErr.Code), 10)
+	}
+	return ClientErrorCode
+}
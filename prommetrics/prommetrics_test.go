@@ -0,0 +1,42 @@
+package prommetrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	m := New("antidote")
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(m)
+
+	host := antidote.Host{Name: "127.0.0.1", Port: 8087}
+	m.OperationDone(antidote.OpRead, host, time.Millisecond, nil)
+	m.OperationDone(antidote.OpUpdate, host, time.Millisecond, &antidote.ServerError{Code: 3})
+	m.OperationDone(antidote.OpUpdate, host, time.Millisecond, errors.New("broken pipe"))
+	m.PoolUsage(host, 2, 5)
+	m.BytesSent(host, 10)
+	m.BytesSent(host, 5)
+	m.BytesReceived(host, 7)
+
+	if v := testutil.ToFloat64(m.errors.WithLabelValues("update", "3")); v != 1 {
+		t.Fatalf("expected one error with code 3, got %f", v)
+	}
+	if v := testutil.ToFloat64(m.errors.WithLabelValues("update", ClientErrorCode)); v != 1 {
+		t.Fatalf("expected one client error, got %f", v)
+	}
+	if v := testutil.ToFloat64(m.poolInUse.WithLabelValues("127.0.0.1:8087")); v != 2 {
+		t.Fatalf("expected 2 connections in use, got %f", v)
+	}
+	if v := testutil.ToFloat64(m.bytesSent.WithLabelValues("127.0.0.1:8087")); v != 15 {
+		t.Fatalf("expected 15 bytes sent, got %f", v)
+	}
+	if n := testutil.CollectAndCount(m, "antidote_operation_duration_seconds"); n != 2 {
+		t.Fatalf("expected latency histograms for two operations, got %d", n)
+	}
+}
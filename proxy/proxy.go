@@ -0,0 +1,359 @@
+// Package proxy forwards the traffic of Antidote clients over a limited number of upstream connections.
+//
+// The proxy speaks the protocol-buffer framing of Antidote on both sides.
+// Each request is sent over an idle upstream connection, which is returned to the pool once the response is forwarded.
+// Interactive transactions are sticky: from the start of a transaction until its commit or abort,
+// the requests of the client connection go to the upstream connection the transaction was started on.
+// Clients sending oversized frames, or staying silent with open transactions, are disconnected.
+//
+//	p, err := proxy.New(proxy.Options{Upstream: "10.0.0.1:8087", MaxUpstreamConnections: 8})
+//	l, err := net.Listen("tcp", ":8087")
+//	err = p.Serve(l)
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+)
+
+// Default of Options.MaxUpstreamConnections
+const DefaultMaxUpstreamConnections = 16
+
+// Default of Options.MaxFrameSize
+const DefaultMaxFrameSize = 16 << 20
+
+// Default of Options.PinnedIdleTimeout
+const DefaultPinnedIdleTimeout = time.Minute
+
+// Returned by Serve once the proxy is closed
+var ErrClosed = errors.New("proxy: closed")
+
+// Optional settings of a proxy, Upstream is required.
+type Options struct {
+	// Address of the protocol-buffer interface of the Antidote node
+	Upstream string
+	// Maximum number of connections to Antidote, defaults to DefaultMaxUpstreamConnections.
+	// Client requests wait for a connection when all are in use.
+	MaxUpstreamConnections int
+	// Maximum rate of requests of each client, identified by its IP address; 0 disables rate limiting.
+	// Requests exceeding the rate are delayed.
+	RequestsPerSecond float64
+	// Number of requests a client can send at once when rate limited, defaults to 1
+	Burst int
+	// Maximum size in bytes of the frames read from clients, defaults to DefaultMaxFrameSize.
+	// Clients sending larger frames are disconnected before the frame is read.
+	MaxFrameSize uint32
+	// Time a client with open interactive transactions may stay silent, defaults to DefaultPinnedIdleTimeout; negative disables it.
+	// The client is disconnected afterwards, which releases the upstream connection of its transactions.
+	PinnedIdleTimeout time.Duration
+	// Receives a message for every client connection and, at debug level, for every request; nil disables logging
+	Logger *slog.Logger
+	// Records every frame forwarded, in the format of recordings of clients; nil disables recording.
+	// Each client connection is recorded as a connection of its own, the recording can be replayed with package replay.
+	Recorder *antidote.Recorder
+}
+
+// Forwards client connections to an Antidote node.
+type Proxy struct {
+	options  Options
+	upstream *upstreamPool
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	mutex     sync.Mutex
+	closed    bool
+	listeners map[net.Listener]struct{}
+	clients   map[net.Conn]struct{}
+	limiters  map[string]*clientLimiter
+	sessions  sync.WaitGroup
+}
+
+// Rate limiter shared by the connections of a client
+type clientLimiter struct {
+	limiter     *rate.Limiter
+	connections int
+}
+
+// Creates a proxy forwarding to options.Upstream.
+func New(options Options) (*Proxy, error) {
+	if options.Upstream == "" {
+		return nil, errors.New("proxy: no upstream address")
+	}
+	if options.MaxUpstreamConnections <= 0 {
+		options.MaxUpstreamConnections = DefaultMaxUpstreamConnections
+	}
+	if options.Burst <= 0 {
+		options.Burst = 1
+	}
+	if options.MaxFrameSize == 0 {
+		options.MaxFrameSize = DefaultMaxFrameSize
+	}
+	if options.PinnedIdleTimeout == 0 {
+		options.PinnedIdleTimeout = DefaultPinnedIdleTimeout
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	dialer := &net.Dialer{}
+	return &Proxy{
+		options: options,
+		upstream: newUpstreamPool(options.MaxUpstreamConnections, func(ctx context.Context) (net.Conn, error) {
+			return dialer.DialContext(ctx, "tcp", options.Upstream)
+		}),
+		ctx:       ctx,
+		cancel:    cancel,
+		listeners: make(map[net.Listener]struct{}),
+		clients:   make(map[net.Conn]struct{}),
+		limiters:  make(map[string]*clientLimiter),
+	}, nil
+}
+
+// Accepts client connections until the listener fails or the proxy is closed.
+// Returns ErrClosed once the proxy is closed.
+func (p *Proxy) Serve(l net.Listener) error {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return ErrClosed
+	}
+	p.listeners[l] = struct{}{}
+	p.mutex.Unlock()
+	defer func() {
+		p.mutex.Lock()
+		delete(p.listeners, l)
+		p.mutex.Unlock()
+	}()
+
+	for {
+		con, err := l.Accept()
+		if err != nil {
+			if p.ctx.Err() != nil {
+				return ErrClosed
+			}
+			return err
+		}
+		if !p.addClient(con) {
+			con.Close()
+			return ErrClosed
+		}
+		go p.serveClient(con)
+	}
+}
+
+// Closes the listeners and all connections.
+// Interactive transactions still open are aborted by Antidote when their connection is closed.
+func (p *Proxy) Close() error {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return nil
+	}
+	p.closed = true
+	p.cancel()
+	for l := range p.listeners {
+		l.Close()
+	}
+	for c := range p.clients {
+		c.Close()
+	}
+	p.mutex.Unlock()
+	p.upstream.close()
+	p.sessions.Wait()
+	return nil
+}
+
+func (p *Proxy) addClient(con net.Conn) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.closed {
+		return false
+	}
+	p.clients[con] = struct{}{}
+	p.sessions.Add(1)
+	return true
+}
+
+func (p *Proxy) removeClient(con net.Conn) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.clients, con)
+	p.sessions.Done()
+}
+
+// Returns the rate limiter of the client, nil if rate limiting is disabled
+func (p *Proxy) acquireLimiter(client string) *clientLimiter {
+	if p.options.RequestsPerSecond <= 0 {
+		return nil
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	l, ok := p.limiters[client]
+	if !ok {
+		l = &clientLimiter{limiter: rate.NewLimiter(rate.Limit(p.options.RequestsPerSecond), p.options.Burst)}
+		p.limiters[client] = l
+	}
+	l.connections++
+	return l
+}
+
+func (p *Proxy) releaseLimiter(client string, l *clientLimiter) {
+	if l == nil {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if l.connections--; l.connections == 0 {
+		delete(p.limiters, client)
+	}
+}
+
+// State of a client connection
+type session struct {
+	con     net.Conn
+	client  string
+	limiter *clientLimiter
+	// number of the connection in the recording
+	recording uint64
+	// upstream connection of the open interactive transactions, nil if there are none
+	pinned *upstreamConn
+	// number of interactive transactions started and not yet committed or aborted
+	openTransactions int
+}
+
+func (p *Proxy) serveClient(con net.Conn) {
+	defer p.removeClient(con)
+	s := &session{con: con, client: con.RemoteAddr().String()}
+	ip := s.client
+	if host, _, err := net.SplitHostPort(s.client); err == nil {
+		ip = host
+	}
+	s.limiter = p.acquireLimiter(ip)
+	if p.options.Recorder != nil {
+		s.recording = p.options.Recorder.NewConnection()
+	}
+	defer p.releaseLimiter(ip, s.limiter)
+	p.log(slog.LevelInfo, "client connected", slog.String("client", s.client))
+
+	err := p.forward(s)
+	if s.pinned != nil {
+		// the transactions cannot be continued on another connection, closing it makes Antidote abort them
+		p.upstream.discard(s.pinned)
+	}
+	con.Close()
+	attrs := []interface{}{slog.String("client", s.client)}
+	switch {
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		attrs = append(attrs, slog.String("error", "idle with open transactions"))
+	case err != nil && !errors.Is(err, io.EOF) && p.ctx.Err() == nil:
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	p.log(slog.LevelInfo, "client disconnected", attrs...)
+}
+
+// Forwards the requests of the client until it closes the connection or forwarding fails.
+// Fails when the client stays silent for longer than PinnedIdleTimeout while its transactions hold an upstream connection.
+func (p *Proxy) forward(s *session) error {
+	for {
+		var deadline time.Time
+		if s.pinned != nil && p.options.PinnedIdleTimeout > 0 {
+			deadline = time.Now().Add(p.options.PinnedIdleTimeout)
+		}
+		if err := s.con.SetReadDeadline(deadline); err != nil {
+			return err
+		}
+		code, payload, err := antidote.ReadFrameLimit(s.con, p.options.MaxFrameSize)
+		if err != nil {
+			return err
+		}
+		if s.limiter != nil {
+			if err = s.limiter.limiter.Wait(p.ctx); err != nil {
+				return err
+			}
+		}
+		start := time.Now()
+		up := s.pinned
+		if up == nil {
+			if up, err = p.upstream.get(p.ctx); err != nil {
+				p.log(slog.LevelWarn, "no upstream connection", slog.String("client", s.client), slog.String("error", err.Error()))
+				return err
+			}
+		}
+		p.record(s, true, code, payload)
+		respCode, resp, err := exchange(up, code, payload)
+		if err != nil {
+			p.log(slog.LevelWarn, "upstream failed", slog.String("client", s.client), slog.String("error", err.Error()))
+			s.pinned = nil
+			p.upstream.discard(up)
+			return err
+		}
+		p.trackTransactions(s, code, respCode, resp)
+		if s.openTransactions > 0 {
+			s.pinned = up
+		} else {
+			s.pinned = nil
+			p.upstream.put(up)
+		}
+		p.record(s, false, respCode, resp)
+		p.log(slog.LevelDebug, "request forwarded",
+			slog.String("client", s.client),
+			slog.String("request", code.String()),
+			slog.String("response", respCode.String()),
+			slog.Int("size", len(payload)+1),
+			slog.Duration("duration", time.Since(start)))
+		if err = antidote.WriteFrame(s.con, respCode, resp); err != nil {
+			return err
+		}
+	}
+}
+
+// Sends a request over the upstream connection and reads the response
+func exchange(up *upstreamConn, code antidote.MessageCode, payload []byte) (respCode antidote.MessageCode, resp []byte, err error) {
+	if err = antidote.WriteFrame(up, code, payload); err != nil {
+		return
+	}
+	return antidote.ReadFrame(up)
+}
+
+// Counts the interactive transactions of the session started and ended by the request
+func (p *Proxy) trackTransactions(s *session, code, respCode antidote.MessageCode, resp []byte) {
+	switch code {
+	case antidote.MsgStartTransaction:
+		started := &antidote.ApbStartTransactionResp{}
+		if respCode == antidote.MsgStartTransactionResp && proto.Unmarshal(resp, started) == nil && started.GetSuccess() {
+			s.openTransactions++
+		}
+	case antidote.MsgCommitTransaction, antidote.MsgAbortTransaction:
+		if s.openTransactions > 0 {
+			s.openTransactions--
+		}
+	}
+}
+
+func (p *Proxy) record(s *session, request bool, code antidote.MessageCode, payload []byte) {
+	if p.options.Recorder != nil {
+		p.options.Recorder.Record(antidote.RecordedFrame{
+			Time:       time.Now(),
+			Connection: s.recording,
+			Host:       p.options.Upstream,
+			Client:     s.client,
+			Sent:       request,
+			Code:       code,
+			Type:       code.String(),
+			Payload:    payload,
+		})
+	}
+}
+
+func (p *Proxy) log(level slog.Level, msg string, args ...interface{}) {
+	if p.options.Logger != nil && p.options.Logger.Enabled(context.Background(), level) {
+		p.options.Logger.Log(context.Background(), level, msg, args...)
+	}
+}
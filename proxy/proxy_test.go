@@ -0,0 +1,263 @@
+package proxy
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/internal/antidotetest"
+	"github.com/AntidoteDB/antidote-go-client/replay"
+	"google.golang.org/protobuf/proto"
+)
+
+// A request received by a fakeAntidote and the number of the connection it was received on
+type received struct {
+	con  int
+	code antidote.MessageCode
+}
+
+// Minimal stand-in for Antidote answering static reads of counters with the number of the connection
+type fakeAntidote struct {
+	*antidotetest.Server
+
+	mutex    sync.Mutex
+	requests []received
+}
+
+func newFakeAntidote(t *testing.T) *fakeAntidote {
+	f := &fakeAntidote{}
+	f.Server = antidotetest.NewServer(t, f.respond)
+	return f
+}
+
+func (f *fakeAntidote) respond(id int, code antidote.MessageCode, req proto.Message) (antidote.MessageCode, proto.Message) {
+	f.mutex.Lock()
+	f.requests = append(f.requests, received{id, code})
+	f.mutex.Unlock()
+	success := true
+	switch code {
+	case antidote.MsgStaticReadObjects:
+		objects := make([]*antidote.ApbReadObjectResp, len(req.(*antidote.ApbStaticReadObjects).Objects))
+		for i := range objects {
+			objects[i] = &antidote.ApbReadObjectResp{Counter: &antidote.ApbGetCounterResp{Value: proto.Int32(int32(id))}}
+		}
+		return antidote.MsgStaticReadObjectsResp, &antidote.ApbStaticReadObjectsResp{
+			Objects:    &antidote.ApbReadObjectsResp{Success: &success, Objects: objects},
+			Committime: &antidote.ApbCommitResp{Success: &success},
+		}
+	case antidote.MsgStartTransaction:
+		return antidote.MsgStartTransactionResp, &antidote.ApbStartTransactionResp{Success: &success, TransactionDescriptor: []byte("tx")}
+	case antidote.MsgCommitTransaction, antidote.MsgStaticUpdateObjects:
+		return antidote.MsgCommitResp, &antidote.ApbCommitResp{Success: &success}
+	}
+	return antidote.MsgOperationResp, &antidote.ApbOperationResp{Success: &success}
+}
+
+func (f *fakeAntidote) stats() (connections int, requests []received) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.Connections(), append([]received(nil), f.requests...)
+}
+
+// Starts a proxy in front of a fakeAntidote and returns the address to connect to
+func startProxy(t *testing.T, f *fakeAntidote, options Options) (*Proxy, antidote.Host) {
+	options.Upstream = f.Host().String()
+	p, err := New(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go p.Serve(l)
+	t.Cleanup(func() { p.Close() })
+	addr := l.Addr().(*net.TCPAddr)
+	return p, antidote.Host{Name: addr.IP.String(), Port: addr.Port}
+}
+
+// Sends a request over the connection and returns the code of the response
+func send(t *testing.T, con net.Conn, code antidote.MessageCode, msg proto.Message) antidote.MessageCode {
+	t.Helper()
+	data, _ := proto.Marshal(msg)
+	if err := antidote.WriteFrame(con, code, data); err != nil {
+		t.Fatal(err)
+	}
+	respCode, _, err := antidote.ReadFrame(con)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return respCode
+}
+
+func TestProxyFanIn(t *testing.T) {
+	f := newFakeAntidote(t)
+	_, host := startProxy(t, f, Options{MaxUpstreamConnections: 2})
+	client, err := antidote.NewClient(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bucket := antidote.Bucket{Bucket: []byte("b")}
+			for j := 0; j < 10; j++ {
+				if _, err := bucket.ReadCounter(client.CreateStaticTransaction(), antidote.Key("k")); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	if connections, requests := f.stats(); connections > 2 || len(requests) != 200 {
+		t.Fatalf("expected 200 requests over at most 2 connections, got %d over %d", len(requests), connections)
+	}
+}
+
+func TestProxyStickyTransactions(t *testing.T) {
+	f := newFakeAntidote(t)
+	_, host := startProxy(t, f, Options{MaxUpstreamConnections: 2})
+	dial := func() net.Conn {
+		con, err := net.Dial("tcp", host.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { con.Close() })
+		return con
+	}
+	txCon, otherCon := dial(), dial()
+	read := &antidote.ApbStaticReadObjects{
+		Transaction: &antidote.ApbStartTransaction{},
+		Objects:     []*antidote.ApbBoundObject{{Bucket: []byte("b"), Key: []byte("k"), Type: antidote.CRDTType_COUNTER.Enum()}},
+	}
+
+	if send(t, txCon, antidote.MsgStartTransaction, &antidote.ApbStartTransaction{}) != antidote.MsgStartTransactionResp {
+		t.Fatal("expected start transaction response")
+	}
+	// the other client gets the second connection while the transaction is open
+	for i := 0; i < 3; i++ {
+		send(t, otherCon, antidote.MsgStaticReadObjects, read)
+		send(t, txCon, antidote.MsgReadObjects, &antidote.ApbReadObjects{TransactionDescriptor: []byte("tx")})
+	}
+	send(t, txCon, antidote.MsgCommitTransaction, &antidote.ApbCommitTransaction{TransactionDescriptor: []byte("tx")})
+
+	_, requests := f.stats()
+	txConnection := requests[0].con
+	for _, r := range requests {
+		inTransaction := r.code != antidote.MsgStaticReadObjects
+		if inTransaction != (r.con == txConnection) {
+			t.Fatalf("request %s on unexpected connection %d, transaction on %d", r.code, r.con, txConnection)
+		}
+	}
+
+	// after the commit, the connection is shared again
+	for i := 0; i < 4; i++ {
+		send(t, otherCon, antidote.MsgStaticReadObjects, read)
+	}
+	if connections, _ := f.stats(); connections != 2 {
+		t.Fatalf("expected 2 upstream connections, got %d", connections)
+	}
+}
+
+func TestProxyAbortsOnDisconnect(t *testing.T) {
+	f := newFakeAntidote(t)
+	_, host := startProxy(t, f, Options{MaxUpstreamConnections: 1})
+	con, err := net.Dial("tcp", host.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(t, con, antidote.MsgStartTransaction, &antidote.ApbStartTransaction{})
+	con.Close()
+
+	// the connection of the abandoned transaction is replaced by a new one
+	con, err = net.Dial("tcp", host.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer con.Close()
+	send(t, con, antidote.MsgStartTransaction, &antidote.ApbStartTransaction{})
+	if connections, _ := f.stats(); connections != 2 {
+		t.Fatalf("expected a new upstream connection, got %d connections", connections)
+	}
+}
+
+func TestProxyLimits(t *testing.T) {
+	f := newFakeAntidote(t)
+	_, host := startProxy(t, f, Options{MaxUpstreamConnections: 1, MaxFrameSize: 64, PinnedIdleTimeout: 50 * time.Millisecond})
+	dial := func() net.Conn {
+		con, err := net.Dial("tcp", host.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { con.Close() })
+		return con
+	}
+
+	// frames above the limit are rejected by their size
+	con := dial()
+	con.Write([]byte{0xff, 0xff, 0xff, 0xff})
+	if _, _, err := antidote.ReadFrame(con); err == nil {
+		t.Fatal("expected client sending an oversized frame to be disconnected")
+	}
+
+	// a silent client with an open transaction loses the only upstream connection
+	idle, other := dial(), dial()
+	send(t, idle, antidote.MsgStartTransaction, &antidote.ApbStartTransaction{})
+	if send(t, other, antidote.MsgStartTransaction, &antidote.ApbStartTransaction{}) != antidote.MsgStartTransactionResp {
+		t.Fatal("expected start transaction response")
+	}
+	if _, _, err := antidote.ReadFrame(idle); err == nil {
+		t.Fatal("expected idle client to be disconnected")
+	}
+}
+
+func TestProxyRateLimitAndRecord(t *testing.T) {
+	f := newFakeAntidote(t)
+	buf := &bytes.Buffer{}
+	recorder := antidote.NewRecorder(buf)
+	_, host := startProxy(t, f, Options{RequestsPerSecond: 20, Recorder: recorder})
+	con, err := net.Dial("tcp", host.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer con.Close()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		send(t, con, antidote.MsgStartTransaction, &antidote.ApbStartTransaction{})
+	}
+	// the first request is allowed immediately, the others every 50ms
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected requests to be delayed, took %v", elapsed)
+	}
+
+	// taking the lock of the recorder orders the frames written before
+	if err = recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+	frames, err := antidote.ReadRecording(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 10 || !frames[0].Sent || frames[0].Code != antidote.MsgStartTransaction || frames[0].Connection == 0 ||
+		frames[1].Sent || frames[1].Code != antidote.MsgStartTransactionResp || frames[0].Client != con.LocalAddr().String() {
+		t.Fatalf("unexpected frames %+v", frames)
+	}
+	// the recording can be replayed
+	if exchanges, err := replay.Exchanges(frames); err != nil || len(exchanges) != 5 {
+		t.Fatalf("expected 5 exchanges, got %d (%v)", len(exchanges), err)
+	}
+}
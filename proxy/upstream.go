@@ -0,0 +1,97 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"sync"
+)
+
+// A connection to Antidote
+type upstreamConn struct {
+	net.Conn
+}
+
+// Limits the number of connections to Antidote and keeps idle connections for reuse.
+type upstreamPool struct {
+	dial func(ctx context.Context) (net.Conn, error)
+	idle chan *upstreamConn
+	// holds a token for every open connection
+	slots chan struct{}
+
+	mutex  sync.Mutex
+	closed bool
+	all    map[*upstreamConn]struct{}
+}
+
+func newUpstreamPool(size int, dial func(ctx context.Context) (net.Conn, error)) *upstreamPool {
+	return &upstreamPool{
+		dial:  dial,
+		idle:  make(chan *upstreamConn, size),
+		slots: make(chan struct{}, size),
+		all:   make(map[*upstreamConn]struct{}),
+	}
+}
+
+// Returns an idle connection, or a new one if the limit is not reached.
+// Waits for a connection to become idle otherwise.
+func (p *upstreamPool) get(ctx context.Context) (*upstreamConn, error) {
+	select {
+	case c := <-p.idle:
+		return c, nil
+	default:
+	}
+	select {
+	case c := <-p.idle:
+		return c, nil
+	case p.slots <- struct{}{}:
+		con, err := p.dial(ctx)
+		if err != nil {
+			<-p.slots
+			return nil, err
+		}
+		c := &upstreamConn{con}
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		if p.closed {
+			con.Close()
+			<-p.slots
+			return nil, ErrClosed
+		}
+		p.all[c] = struct{}{}
+		return c, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Returns a connection for reuse
+func (p *upstreamPool) put(c *upstreamConn) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.closed {
+		return
+	}
+	// never blocks, there are at most as many connections as the capacity of idle
+	p.idle <- c
+}
+
+// Closes a connection that must not be reused
+func (p *upstreamPool) discard(c *upstreamConn) {
+	c.Close()
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if _, ok := p.all[c]; ok {
+		delete(p.all, c)
+		<-p.slots
+	}
+}
+
+// Closes all connections, including the ones in use
+func (p *upstreamPool) close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.closed = true
+	for c := range p.all {
+		c.Close()
+	}
+}
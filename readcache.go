@@ -0,0 +1,186 @@
+package antidoteclient
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Configures staleness and size of a ReadCache.
+type ReadCacheOptions struct {
+	// Maximum age of a cached value before it is read again from Antidote; defaults to one second
+	MaxStaleness time.Duration
+	// Maximum number of cached objects, least recently used objects are evicted first; defaults to 1000
+	MaxEntries int
+}
+
+// Hit and miss statistics of a ReadCache.
+type ReadCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+type cacheKey struct {
+	bucket   string
+	key      string
+	crdtType CRDTType
+}
+
+type cacheEntry struct {
+	key       cacheKey
+	value     *ApbReadObjectResp
+	fetchedAt time.Time
+}
+
+// Serves static reads from memory for a bounded staleness window.
+// Objects are cached by bucket, key and type.
+// Updates committed through the client the cache was created from, with static or interactive transactions,
+// invalidate the cached values of the updated objects; updates of other clients become visible after at most MaxStaleness.
+//
+// A ReadCache implements the Transaction interface and can be used with the read functions of Bucket.
+// Updates are passed through to a static transaction.
+// A ReadCache is safe for concurrent use.
+type ReadCache struct {
+	client  *Client
+	tx      *StaticTransaction
+	options ReadCacheOptions
+
+	mutex   sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+	// incremented by invalidations, reads started before an invalidation are not cached
+	generation uint64
+	stats      ReadCacheStats
+}
+
+// Creates a read cache for static reads of this client.
+// Close the cache when it is no longer used.
+func (client *Client) NewReadCache(options ReadCacheOptions) *ReadCache {
+	if options.MaxStaleness <= 0 {
+		options.MaxStaleness = time.Second
+	}
+	if options.MaxEntries <= 0 {
+		options.MaxEntries = 1000
+	}
+	cache := &ReadCache{
+		client:  client,
+		tx:      client.CreateStaticTransaction(),
+		options: options,
+		entries: make(map[cacheKey]*list.Element),
+		lru:     list.New(),
+	}
+	client.addCache(cache)
+	return cache
+}
+
+func newCacheKey(object *ApbBoundObject) cacheKey {
+	return cacheKey{string(object.Bucket), string(object.Key), object.GetType()}
+}
+
+// Reads the objects, serving fresh values from the cache and reading the others with one static transaction.
+func (cache *ReadCache) Read(objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	results := make([]*ApbReadObjectResp, len(objects))
+	var missing []*ApbBoundObject
+	var missingIdx []int
+
+	cache.mutex.Lock()
+	now := time.Now()
+	for i, o := range objects {
+		if e, ok := cache.entries[newCacheKey(o)]; ok && now.Sub(e.Value.(*cacheEntry).fetchedAt) <= cache.options.MaxStaleness {
+			cache.lru.MoveToFront(e)
+			results[i] = proto.Clone(e.Value.(*cacheEntry).value).(*ApbReadObjectResp)
+			cache.stats.Hits++
+		} else {
+			missing = append(missing, o)
+			missingIdx = append(missingIdx, i)
+			cache.stats.Misses++
+		}
+	}
+	generation := cache.generation
+	cache.mutex.Unlock()
+
+	if len(missing) > 0 {
+		fetched, err := cache.tx.Read(missing...)
+		if err != nil {
+			return nil, err
+		}
+		if len(fetched.Objects) != len(missing) {
+			return nil, fmt.Errorf("read %d objects, got %d values", len(missing), len(fetched.Objects))
+		}
+		cache.mutex.Lock()
+		for j, obj := range fetched.Objects {
+			results[missingIdx[j]] = obj
+			if cache.generation == generation {
+				cache.put(newCacheKey(missing[j]), proto.Clone(obj).(*ApbReadObjectResp), now)
+			}
+		}
+		cache.mutex.Unlock()
+	}
+	success := true
+	return &ApbReadObjectsResp{Success: &success, Objects: results}, nil
+}
+
+// Passes the updates through to a static transaction, which invalidates the updated objects.
+func (cache *ReadCache) Update(updates ...*ApbUpdateOp) error {
+	return cache.tx.Update(updates...)
+}
+
+// Removes the given objects from the cache.
+func (cache *ReadCache) Invalidate(objects ...*ApbBoundObject) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.generation++
+	for _, o := range objects {
+		if e, ok := cache.entries[newCacheKey(o)]; ok {
+			cache.lru.Remove(e)
+			delete(cache.entries, newCacheKey(o))
+		}
+	}
+}
+
+// Removes all objects from the cache.
+func (cache *ReadCache) Purge() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.generation++
+	cache.entries = make(map[cacheKey]*list.Element)
+	cache.lru.Init()
+}
+
+// Returns hit and miss statistics of the cache.
+func (cache *ReadCache) Stats() ReadCacheStats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	stats := cache.stats
+	stats.Entries = cache.lru.Len()
+	return stats
+}
+
+// Detaches the cache from the client; it no longer receives invalidations.
+func (cache *ReadCache) Close() {
+	cache.client.removeCache(cache)
+	cache.Purge()
+}
+
+// Stores a value; the caller has to hold the mutex.
+func (cache *ReadCache) put(key cacheKey, value *ApbReadObjectResp, fetchedAt time.Time) {
+	if e, ok := cache.entries[key]; ok {
+		entry := e.Value.(*cacheEntry)
+		entry.value = value
+		entry.fetchedAt = fetchedAt
+		cache.lru.MoveToFront(e)
+		return
+	}
+	cache.entries[key] = cache.lru.PushFront(&cacheEntry{key: key, value: value, fetchedAt: fetchedAt})
+	for cache.lru.Len() > cache.options.MaxEntries {
+		oldest := cache.lru.Back()
+		cache.lru.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*cacheEntry).key)
+		cache.stats.Evictions++
+	}
+}
@@ -0,0 +1,113 @@
+package antidoteclient
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Answers static reads with counters holding the number of reads served so far
+func countingReadHandler(reads *int32) fakeHandler {
+	return func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		if code != MsgStaticReadObjects {
+			return ackHandler(code, data)
+		}
+		req := &ApbStaticReadObjects{}
+		proto.Unmarshal(data, req)
+		n := atomic.AddInt32(reads, 1)
+		success := true
+		objects := make([]*ApbReadObjectResp, len(req.Objects))
+		for i := range objects {
+			objects[i] = &ApbReadObjectResp{Counter: &ApbGetCounterResp{Value: &n}}
+		}
+		return MsgStaticReadObjectsResp, &ApbStaticReadObjectsResp{
+			Objects:    &ApbReadObjectsResp{Success: &success, Objects: objects},
+			Committime: &ApbCommitResp{Success: &success},
+		}
+	}
+}
+
+func TestReadCache(t *testing.T) {
+	var reads int32
+	server := newFakeServer(t, countingReadHandler(&reads))
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	cache := client.NewReadCache(ReadCacheOptions{MaxStaleness: time.Hour, MaxEntries: 2})
+	defer cache.Close()
+	bucket := Bucket{[]byte("bucket")}
+
+	for i := 0; i < 3; i++ {
+		if v, err := bucket.ReadCounter(cache, Key("a")); err != nil || v != 1 {
+			t.Fatalf("unexpected value %d (%v)", v, err)
+		}
+	}
+	if s := cache.Stats(); s.Hits != 2 || s.Misses != 1 || s.Entries != 1 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+
+	// committing an update through the client invalidates the cached object
+	if err = bucket.Update(client.CreateStaticTransaction(), CounterInc(Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := bucket.ReadCounter(cache, Key("a")); v != 2 {
+		t.Fatalf("expected value to be read again after update, got %d", v)
+	}
+
+	bucket.ReadCounter(cache, Key("b"))
+	bucket.ReadCounter(cache, Key("c"))
+	if s := cache.Stats(); s.Entries != 2 || s.Evictions != 1 {
+		t.Fatalf("unexpected stats after eviction: %+v", s)
+	}
+}
+
+func TestReadCacheStaleness(t *testing.T) {
+	var reads int32
+	server := newFakeServer(t, countingReadHandler(&reads))
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	cache := client.NewReadCache(ReadCacheOptions{MaxStaleness: 10 * time.Millisecond})
+	defer cache.Close()
+	bucket := Bucket{[]byte("bucket")}
+
+	bucket.ReadCounter(cache, Key("a"))
+	time.Sleep(20 * time.Millisecond)
+	if v, _ := bucket.ReadCounter(cache, Key("a")); v != 2 {
+		t.Fatalf("expected stale value to be read again, got %d", v)
+	}
+}
+
+func TestReadCacheValueCount(t *testing.T) {
+	for _, count := range []int{0, 2} {
+		server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+			success := true
+			objects := make([]*ApbReadObjectResp, count)
+			for i := range objects {
+				objects[i] = &ApbReadObjectResp{Counter: &ApbGetCounterResp{Value: proto.Int32(1)}}
+			}
+			return MsgStaticReadObjectsResp, &ApbStaticReadObjectsResp{
+				Objects:    &ApbReadObjectsResp{Success: &success, Objects: objects},
+				Committime: &ApbCommitResp{Success: &success},
+			}
+		})
+		client, err := NewClient(server.host())
+		if err != nil {
+			t.Fatal(err)
+		}
+		cache := client.NewReadCache(ReadCacheOptions{})
+		if _, err = (&Bucket{[]byte("bucket")}).ReadCounter(cache, Key("a")); err == nil {
+			t.Fatalf("expected an error for %d values", count)
+		}
+		cache.Close()
+		client.Close()
+	}
+}
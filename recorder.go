@@ -0,0 +1,115 @@
+package antidoteclient
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/fatih/pool.v2"
+)
+
+// A frame sent or received by a client, as written by a Recorder.
+type RecordedFrame struct {
+	Time time.Time `json:"time"`
+	// Identifies the network connection the frame was exchanged on, unique within the recording, see Recorder.NewConnection
+	Connection uint64 `json:"connection"`
+	Host       string `json:"host"`
+	// Remote address of the client, for frames recorded by a proxy
+	Client string `json:"client,omitempty"`
+	// True for requests sent to Antidote, false for responses received
+	Sent bool        `json:"sent"`
+	Code MessageCode `json:"code"`
+	// Name of the message type, for readers of the recording
+	Type string `json:"type"`
+	// The encoded message
+	Payload []byte `json:"payload"`
+}
+
+// Writes every frame sent and received by a client as a line of JSON, see ClientOptions.Recorder.
+// Safe for concurrent use by several clients; the recorder numbers the connections of all of them.
+type Recorder struct {
+	// number of the last connection
+	connections uint64
+
+	mutex sync.Mutex
+	enc   *json.Encoder
+	err   error
+}
+
+// Creates a recorder writing to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// Returns the first error writing a frame; frames are no longer written after an error
+func (r *Recorder) Err() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.err
+}
+
+// Returns a number identifying a connection in the recording.
+// Clients number the connections they dial with it; programs recording other connections, e.g. proxies, can do the same.
+func (r *Recorder) NewConnection() uint64 {
+	return atomic.AddUint64(&r.connections, 1)
+}
+
+// Writes a frame, e.g. one forwarded by a proxy; Connection should be obtained from NewConnection
+func (r *Recorder) Record(frame RecordedFrame) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.err == nil {
+		r.err = r.enc.Encode(frame)
+	}
+}
+
+// Reads the frames written by a Recorder
+func ReadRecording(r io.Reader) (frames []RecordedFrame, err error) {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var frame RecordedFrame
+		if err = dec.Decode(&frame); err == io.EOF {
+			return frames, nil
+		} else if err != nil {
+			return nil, err
+		}
+		frames = append(frames, frame)
+	}
+}
+
+// A network connection numbered when dialed, to identify it in recordings
+type dialedConn struct {
+	net.Conn
+	id uint64
+}
+
+// Returns the number of a pooled connection, 0 if unknown
+func connectionID(con net.Conn) uint64 {
+	if pc, ok := con.(*pool.PoolConn); ok {
+		con = pc.Conn
+	}
+	if d, ok := con.(*dialedConn); ok {
+		return d.id
+	}
+	return 0
+}
+
+// Records a frame sent or received on the connection, if the client has a recorder
+func (c *connection) recordFrame(sent bool, code MessageCode, payload []byte) {
+	if c.client.recorder == nil {
+		return
+	}
+	c.client.recorder.Record(RecordedFrame{
+		Time:       time.Now(),
+		Connection: c.id,
+		Host:       c.host.host.String(),
+		Sent:       sent,
+		Code:       code,
+		Type:       code.String(),
+		Payload:    payload,
+	})
+}
@@ -0,0 +1,84 @@
+package antidoteclient
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	buf := &bytes.Buffer{}
+	recorder := NewRecorder(buf)
+	client, err := NewClientWithOptions(ClientOptions{Recorder: recorder}, server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bucket := Bucket{Bucket: []byte("bucket")}
+	if err = bucket.Update(tx, CounterInc(Key("key"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if recorder.Err() != nil {
+		t.Fatal(recorder.Err())
+	}
+
+	frames, err := ReadRecording(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []MessageCode{MsgStartTransaction, MsgStartTransactionResp, MsgUpdateObjects, MsgOperationResp, MsgCommitTransaction, MsgCommitResp}
+	if len(frames) != len(expected) {
+		t.Fatalf("expected %d frames, got %d", len(expected), len(frames))
+	}
+	for i, f := range frames {
+		if f.Code != expected[i] || f.Sent != (i%2 == 0) || f.Connection != frames[0].Connection || f.Host != server.host().String() || f.Time.IsZero() {
+			t.Fatalf("unexpected frame %d: %+v", i, f)
+		}
+	}
+	if frames[0].Connection == 0 || frames[0].Type != "ApbStartTransaction" {
+		t.Fatalf("unexpected frame %+v", frames[0])
+	}
+	requests := server.received(MsgUpdateObjects)
+	if !bytes.Equal(frames[2].Payload, requests[0].data) {
+		t.Fatal("recorded payload differs from the frame sent")
+	}
+}
+
+func TestRecorderSharedByClients(t *testing.T) {
+	buf := &bytes.Buffer{}
+	recorder := NewRecorder(buf)
+	hosts := make(map[uint64]string)
+	for i := 0; i < 2; i++ {
+		server := newFakeServer(t, ackHandler)
+		client, err := NewClientWithOptions(ClientOptions{Recorder: recorder}, server.host())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		if err = client.CreateStaticTransaction().Update(CounterInc(Key("key"), 1).ConvertToToplevel([]byte("bucket"))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	frames, err := ReadRecording(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range frames {
+		if host, ok := hosts[f.Connection]; ok && host != f.Host {
+			t.Fatalf("connection %d recorded for %s and %s", f.Connection, host, f.Host)
+		}
+		hosts[f.Connection] = f.Host
+	}
+	if len(hosts) != 2 {
+		t.Fatalf("expected frames of 2 connections, got %v", hosts)
+	}
+}
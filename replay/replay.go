@@ -0,0 +1,348 @@
+// Package replay reproduces client sessions recorded with antidote.Recorder.
+//
+// A Server answers the requests of a client with the recorded responses, e.g. to run the code that produced
+// the recording in a regression test without Antidote:
+//
+//	frames, err := antidote.ReadRecording(file)
+//	exchanges, err := replay.Exchanges(frames)
+//	server, err := replay.NewServer(exchanges)
+//	client, err := antidote.NewClient(server.Host())
+//
+// Run sends the recorded requests to a server, e.g. a new Antidote instance or a stand-in,
+// and reports the responses that differ from the recording.
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A request and the response recorded for it
+type Exchange struct {
+	Request  antidote.RecordedFrame
+	Response antidote.RecordedFrame
+}
+
+// Pairs the requests of a recording with their responses.
+// Returns the exchanges in the order of the requests; requests without response, e.g. on failed connections, are left out.
+func Exchanges(frames []antidote.RecordedFrame) ([]Exchange, error) {
+	type pending struct {
+		index   int
+		request antidote.RecordedFrame
+	}
+	requests := make(map[uint64]*pending)
+	var indices []int
+	byIndex := make(map[int]Exchange)
+	for i, f := range frames {
+		p := requests[f.Connection]
+		if f.Sent {
+			requests[f.Connection] = &pending{index: i, request: f}
+			continue
+		}
+		if p == nil {
+			return nil, fmt.Errorf("replay: response %d on connection %d without request", i, f.Connection)
+		}
+		delete(requests, f.Connection)
+		indices = append(indices, p.index)
+		byIndex[p.index] = Exchange{Request: p.request, Response: f}
+	}
+	sort.Ints(indices)
+	exchanges := make([]Exchange, len(indices))
+	for i, index := range indices {
+		exchanges[i] = byIndex[index]
+	}
+	return exchanges, nil
+}
+
+// Fields holding values chosen by the server, which differ between runs
+var volatileFields = map[protoreflect.Name]bool{"transaction_descriptor": true, "commit_time": true, "timestamp": true}
+
+// Returns the values of the volatile fields of the message, in the order of a depth-first traversal
+func volatileValues(m protoreflect.Message) (values [][]byte) {
+	walk(m, func(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+		values = append(values, m.Get(fd).Bytes())
+	})
+	return
+}
+
+// Calls f for every volatile field set in the message and its nested messages
+func walk(m protoreflect.Message, f func(m protoreflect.Message, fd protoreflect.FieldDescriptor)) {
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	for _, fd := range fields {
+		switch {
+		case fd.Kind() == protoreflect.BytesKind && !fd.IsList() && volatileFields[fd.Name()]:
+			f(m, fd)
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := m.Get(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				walk(list.Get(i).Message(), f)
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			walk(m.Get(fd).Message(), f)
+		}
+	}
+}
+
+// Decodes the payload of a frame; nil if the code is not registered or the payload invalid
+func decode(code antidote.MessageCode, payload []byte) proto.Message {
+	msg := antidote.NewMessage(code)
+	if msg == nil || proto.Unmarshal(payload, msg) != nil {
+		return nil
+	}
+	return msg
+}
+
+// Reports whether two responses are equal, apart from the values of volatile fields
+func equalResponses(code antidote.MessageCode, payload []byte, otherCode antidote.MessageCode, otherPayload []byte) bool {
+	if code != otherCode {
+		return false
+	}
+	a, b := decode(code, payload), decode(otherCode, otherPayload)
+	if a == nil || b == nil {
+		return string(payload) == string(otherPayload)
+	}
+	clear := func(m protoreflect.Message, fd protoreflect.FieldDescriptor) { m.Clear(fd) }
+	walk(a.ProtoReflect(), clear)
+	walk(b.ProtoReflect(), clear)
+	return proto.Equal(a, b)
+}
+
+// A response that differs from the recording
+type Mismatch struct {
+	Exchange Exchange
+	// The response received, unset if the request failed
+	Code    antidote.MessageCode
+	Payload []byte
+	// Error sending the request or receiving the response
+	Err error
+}
+
+func (m Mismatch) String() string {
+	if m.Err != nil {
+		return fmt.Sprintf("%s on connection %d failed: %v", m.Exchange.Request.Code, m.Exchange.Request.Connection, m.Err)
+	}
+	return fmt.Sprintf("%s on connection %d: expected %s %s, got %s %s", m.Exchange.Request.Code, m.Exchange.Request.Connection,
+		m.Exchange.Response.Code, format(m.Exchange.Response.Code, m.Exchange.Response.Payload), m.Code, format(m.Code, m.Payload))
+}
+
+func format(code antidote.MessageCode, payload []byte) string {
+	if msg := decode(code, payload); msg != nil {
+		return fmt.Sprintf("{%v}", msg)
+	}
+	return fmt.Sprintf("%q", payload)
+}
+
+// Sends the recorded requests to the server at host in the order of the recording and compares the responses with the recorded ones.
+// Requests recorded on the same connection are sent on the same connection, keeping interactive transactions on their connection.
+// Transaction descriptors and commit times returned by the server replace the recorded ones in later requests,
+// and are ignored when comparing responses.
+// Returns an error if a connection cannot be established.
+func Run(host antidote.Host, exchanges []Exchange) (mismatches []Mismatch, err error) {
+	connections := make(map[uint64]net.Conn)
+	defer func() {
+		for _, con := range connections {
+			con.Close()
+		}
+	}()
+	// live values of volatile fields by recorded value
+	replaced := make(map[string][]byte)
+
+	for _, e := range exchanges {
+		con, ok := connections[e.Request.Connection]
+		if !ok {
+			if con, err = net.Dial("tcp", host.String()); err != nil {
+				return
+			}
+			connections[e.Request.Connection] = con
+		}
+		payload := e.Request.Payload
+		if req := decode(e.Request.Code, payload); req != nil {
+			walk(req.ProtoReflect(), func(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+				if v, ok := replaced[string(m.Get(fd).Bytes())]; ok {
+					m.Set(fd, protoreflect.ValueOfBytes(v))
+				}
+			})
+			payload, _ = proto.Marshal(req)
+		}
+
+		var code antidote.MessageCode
+		var resp []byte
+		if err = antidote.WriteFrame(con, e.Request.Code, payload); err == nil {
+			code, resp, err = antidote.ReadFrame(con)
+		}
+		if err != nil {
+			// the connection is in an unknown state, later requests on it are sent on a new connection
+			con.Close()
+			delete(connections, e.Request.Connection)
+			mismatches = append(mismatches, Mismatch{Exchange: e, Err: err})
+			err = nil
+			continue
+		}
+
+		if recorded, live := decode(e.Response.Code, e.Response.Payload), decode(code, resp); recorded != nil && live != nil {
+			recordedValues, liveValues := volatileValues(recorded.ProtoReflect()), volatileValues(live.ProtoReflect())
+			for i := 0; i < len(recordedValues) && i < len(liveValues); i++ {
+				if len(recordedValues[i]) > 0 {
+					replaced[string(recordedValues[i])] = liveValues[i]
+				}
+			}
+		}
+		if !equalResponses(e.Response.Code, e.Response.Payload, code, resp) {
+			mismatches = append(mismatches, Mismatch{Exchange: e, Code: code, Payload: resp})
+		}
+	}
+	return mismatches, nil
+}
+
+// A request received by a Server without recorded response
+type UnexpectedRequest struct {
+	Code    antidote.MessageCode
+	Payload []byte
+}
+
+// Stand-in for Antidote answering requests with the responses of a recording.
+// A request is answered with the response of the first exchange not replayed yet with an equal request;
+// requests without such an exchange are answered with an error response.
+type Server struct {
+	listener net.Listener
+
+	mutex      sync.Mutex
+	exchanges  []Exchange
+	replayed   []bool
+	unexpected []UnexpectedRequest
+	// connections accepted and not yet closed
+	connections map[net.Conn]struct{}
+	wg          sync.WaitGroup
+}
+
+// Code of the error responses sent for requests without recorded response
+const ErrorCodeNotRecorded = 404
+
+// Starts a server on a local port replaying the exchanges.
+func NewServer(exchanges []Exchange) (*Server, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		listener:    l,
+		exchanges:   exchanges,
+		replayed:    make([]bool, len(exchanges)),
+		connections: make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Address to connect clients to
+func (s *Server) Host() antidote.Host {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return antidote.Host{Name: addr.IP.String(), Port: addr.Port}
+}
+
+// Stops accepting connections and closes the open ones
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.mutex.Lock()
+	for con := range s.connections {
+		con.Close()
+	}
+	s.mutex.Unlock()
+	s.wg.Wait()
+	return err
+}
+
+// Returns the requests answered with an error response as they were not recorded
+func (s *Server) Unexpected() []UnexpectedRequest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]UnexpectedRequest(nil), s.unexpected...)
+}
+
+// Returns the recorded exchanges not replayed so far
+func (s *Server) Remaining() (remaining []Exchange) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i, e := range s.exchanges {
+		if !s.replayed[i] {
+			remaining = append(remaining, e)
+		}
+	}
+	return
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		con, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mutex.Lock()
+		s.connections[con] = struct{}{}
+		s.mutex.Unlock()
+		s.wg.Add(1)
+		go s.handle(con)
+	}
+}
+
+func (s *Server) handle(con net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.connections, con)
+		s.mutex.Unlock()
+		con.Close()
+	}()
+	for {
+		code, payload, err := antidote.ReadFrame(con)
+		if err != nil {
+			return
+		}
+		respCode, resp := s.respond(code, payload)
+		if err = antidote.WriteFrame(con, respCode, resp); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) respond(code antidote.MessageCode, payload []byte) (antidote.MessageCode, []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i, e := range s.exchanges {
+		if !s.replayed[i] && e.Request.Code == code && string(e.Request.Payload) == string(payload) {
+			s.replayed[i] = true
+			return e.Response.Code, e.Response.Payload
+		}
+	}
+	s.unexpected = append(s.unexpected, UnexpectedRequest{Code: code, Payload: payload})
+	resp, _ := proto.Marshal(&antidote.ApbErrorResp{
+		Errmsg:  []byte(fmt.Sprintf("no recorded response for %s", code)),
+		Errcode: proto.Uint32(ErrorCodeNotRecorded),
+	})
+	return antidote.MsgErrorResp, resp
+}
+
+// Returned by Check if a replay did not go as recorded
+var ErrDiverged = errors.New("replay: session diverged from recording")
+
+// Returns ErrDiverged, describing the unexpected requests and the exchanges not replayed, if there are any.
+func (s *Server) Check() error {
+	unexpected, remaining := s.Unexpected(), s.Remaining()
+	if len(unexpected) == 0 && len(remaining) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d unexpected requests, %d recorded exchanges not replayed", ErrDiverged, len(unexpected), len(remaining))
+}
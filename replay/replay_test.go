@@ -0,0 +1,183 @@
+package replay
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	antidote "github.com/AntidoteDB/antidote-go-client"
+	"github.com/AntidoteDB/antidote-go-client/internal/antidotetest"
+	"google.golang.org/protobuf/proto"
+)
+
+// Minimal stand-in for Antidote storing counters.
+// Transaction descriptors and commit times carry a prefix unique to the instance,
+// requests with descriptors of other instances are answered with an error.
+type fakeAntidote struct {
+	*antidotetest.Server
+	prefix string
+
+	mutex    sync.Mutex
+	counters map[string]int32
+	issued   int
+}
+
+func newFakeAntidote(t *testing.T, prefix string) *fakeAntidote {
+	f := &fakeAntidote{prefix: prefix, counters: make(map[string]int32)}
+	f.Server = antidotetest.NewServer(t, f.respond)
+	return f
+}
+
+// Returns a new transaction descriptor or commit time
+func (f *fakeAntidote) issue() []byte {
+	f.issued++
+	return []byte(fmt.Sprintf("%s-%d", f.prefix, f.issued))
+}
+
+func (f *fakeAntidote) read(objects []*antidote.ApbBoundObject) *antidote.ApbReadObjectsResp {
+	resp := &antidote.ApbReadObjectsResp{Success: proto.Bool(true)}
+	for _, o := range objects {
+		resp.Objects = append(resp.Objects, &antidote.ApbReadObjectResp{Counter: &antidote.ApbGetCounterResp{Value: proto.Int32(f.counters[string(o.Key)])}})
+	}
+	return resp
+}
+
+func (f *fakeAntidote) update(updates []*antidote.ApbUpdateOp) {
+	for _, u := range updates {
+		f.counters[string(u.Boundobject.Key)] += int32(u.Operation.Counterop.GetInc())
+	}
+}
+
+func (f *fakeAntidote) respond(_ int, _ antidote.MessageCode, req proto.Message) (antidote.MessageCode, proto.Message) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if d, ok := req.(interface{ GetTransactionDescriptor() []byte }); ok && !bytes.HasPrefix(d.GetTransactionDescriptor(), []byte(f.prefix)) {
+		return antidote.MsgErrorResp, &antidote.ApbErrorResp{Errmsg: []byte("unknown transaction"), Errcode: proto.Uint32(1)}
+	}
+	success := proto.Bool(true)
+	switch r := req.(type) {
+	case *antidote.ApbStaticUpdateObjects:
+		f.update(r.Updates)
+		return antidote.MsgCommitResp, &antidote.ApbCommitResp{Success: success, CommitTime: f.issue()}
+	case *antidote.ApbStaticReadObjects:
+		return antidote.MsgStaticReadObjectsResp, &antidote.ApbStaticReadObjectsResp{
+			Objects:    f.read(r.Objects),
+			Committime: &antidote.ApbCommitResp{Success: success, CommitTime: f.issue()},
+		}
+	case *antidote.ApbStartTransaction:
+		return antidote.MsgStartTransactionResp, &antidote.ApbStartTransactionResp{Success: success, TransactionDescriptor: f.issue()}
+	case *antidote.ApbReadObjects:
+		return antidote.MsgReadObjectsResp, f.read(r.Boundobjects)
+	case *antidote.ApbUpdateObjects:
+		f.update(r.Updates)
+		return antidote.MsgOperationResp, &antidote.ApbOperationResp{Success: success}
+	case *antidote.ApbCommitTransaction:
+		return antidote.MsgCommitResp, &antidote.ApbCommitResp{Success: success, CommitTime: f.issue()}
+	}
+	return antidote.MsgOperationResp, &antidote.ApbOperationResp{Success: success}
+}
+
+// Updates and reads counters in static and interactive transactions, returns the values read
+func session(client *antidote.Client, key string) (values []int32, err error) {
+	bucket := antidote.Bucket{Bucket: []byte("bucket")}
+	if err = bucket.Update(client.CreateStaticTransaction(), antidote.CounterInc(antidote.Key(key), 2)); err != nil {
+		return
+	}
+	tx, err := client.StartTransaction()
+	if err != nil {
+		return
+	}
+	v, err := bucket.ReadCounter(tx, antidote.Key(key))
+	if err != nil {
+		return
+	}
+	values = append(values, v)
+	if err = bucket.Update(tx, antidote.CounterInc(antidote.Key(key), 1)); err != nil {
+		return
+	}
+	if err = tx.Commit(); err != nil {
+		return
+	}
+	v, err = bucket.ReadCounter(client.CreateStaticTransaction(), antidote.Key(key))
+	return append(values, v), err
+}
+
+// Runs the session against a fakeAntidote and returns the values read and the recorded exchanges
+func record(t *testing.T, f *fakeAntidote) ([]int32, []Exchange) {
+	buf := &bytes.Buffer{}
+	client, err := antidote.NewClientWithOptions(antidote.ClientOptions{Recorder: antidote.NewRecorder(buf)}, f.Host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	values, err := session(client, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	frames, err := antidote.ReadRecording(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchanges, err := Exchanges(frames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exchanges) != 6 {
+		t.Fatalf("expected 6 exchanges, got %d", len(exchanges))
+	}
+	return values, exchanges
+}
+
+func TestServer(t *testing.T) {
+	values, exchanges := record(t, newFakeAntidote(t, "a"))
+	server, err := NewServer(exchanges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	client, err := antidote.NewClient(server.Host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	replayed, err := session(client, "key")
+	if err != nil || !reflect.DeepEqual(replayed, values) {
+		t.Fatalf("expected values %v, got %v (%v)", values, replayed, err)
+	}
+	if err = server.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a session diverging from the recording is answered with errors
+	var serr *antidote.ServerError
+	if _, err = session(client, "other"); !errors.As(err, &serr) || serr.Code != ErrorCodeNotRecorded {
+		t.Fatalf("expected error response, got %v", err)
+	}
+	if err = server.Check(); !errors.Is(err, ErrDiverged) || len(server.Unexpected()) != 1 {
+		t.Fatalf("expected diverged session, got %v", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	_, exchanges := record(t, newFakeAntidote(t, "a"))
+
+	// a new instance answers like the recorded one, with its own descriptors and commit times
+	f := newFakeAntidote(t, "b")
+	mismatches, err := Run(f.Host(), exchanges)
+	if err != nil || len(mismatches) != 0 {
+		t.Fatalf("unexpected mismatches %v (%v)", mismatches, err)
+	}
+
+	// replaying again reads the counter updated by the first replay
+	mismatches, err = Run(f.Host(), exchanges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 2 || mismatches[0].Exchange.Request.Code != antidote.MsgReadObjects || mismatches[1].Exchange.Request.Code != antidote.MsgStaticReadObjects {
+		t.Fatalf("expected mismatching reads, got %v", mismatches)
+	}
+}
@@ -0,0 +1,61 @@
+package antidoteclient
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Sends a protocol-buffer message over a pooled connection and decodes the response into resp.
+// The message codes are taken from the registry, see RegisterMessage.
+// Allows to use messages of the Antidote protocol not wrapped by this package.
+// Returns a *ServerError if Antidote answers with an ApbErrorResp.
+func (client *Client) RoundTrip(req proto.Message, resp proto.Message) error {
+	con, err := client.getConnection()
+	if err != nil {
+		return err
+	}
+	err = roundTrip(con, req, resp)
+	if err != nil {
+		con.discard()
+		return err
+	}
+	return con.Close()
+}
+
+// Sends an encoded message with the given code over a pooled connection and returns the code and payload of the response.
+// Unlike RoundTrip, the message types do not have to be registered.
+func (client *Client) RoundTripRaw(code MessageCode, payload []byte) (respCode MessageCode, respPayload []byte, err error) {
+	con, err := client.getConnection()
+	if err != nil {
+		return
+	}
+	err = encodeMsgRaw(code, payload, con)
+	if err != nil {
+		con.discard()
+		return
+	}
+	respCode, respPayload, err = ReadFrame(con)
+	if err != nil {
+		con.discard()
+		return 0, nil, err
+	}
+	err = con.Close()
+	return
+}
+
+// Sends a protocol-buffer message over the connection of the transaction and decodes the response into resp.
+// The message is sent as is, set the transaction descriptor of the message to TransactionDescriptor() if needed.
+func (tx *InteractiveTransaction) RoundTrip(req proto.Message, resp proto.Message) error {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if err := tx.checkActive(); err != nil {
+		return err
+	}
+	return tx.roundTrip(context.Background(), req, resp)
+}
+
+// The descriptor identifying the transaction on the Antidote server
+func (tx *InteractiveTransaction) TransactionDescriptor() []byte {
+	return tx.txID
+}
@@ -0,0 +1,108 @@
+package antidoteclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Time given to in-flight operations and aborts of open transactions once the context of Shutdown is done
+const shutdownAbortTimeout = time.Second
+
+// Shuts the client down gracefully and closes the connections.
+// Sends the updates buffered in update queues and write batches of this client,
+// then stops handing out connections and waits until in-flight operations finish and open interactive transactions
+// are committed or aborted.
+// When the context is done before, open transactions are aborted and in-flight operations get shutdownAbortTimeout to finish,
+// after which their connections are closed and Shutdown returns without waiting further.
+// Returns the errors of flushing buffered updates and aborting transactions, and the error of the context if it is done.
+func (client *Client) Shutdown(ctx context.Context) error {
+	var errs []error
+	client.mutex.Lock()
+	queues, batches := client.queues, client.batches
+	client.queues, client.batches = nil, nil
+	client.mutex.Unlock()
+	for _, q := range queues {
+		errs = append(errs, q.Close())
+	}
+	for _, b := range batches {
+		errs = append(errs, b.Close())
+	}
+
+	client.mutex.Lock()
+	client.closing = true
+	idle := make(chan struct{})
+	if client.inFlight == 0 {
+		close(idle)
+	} else {
+		client.idle = idle
+	}
+	client.mutex.Unlock()
+
+	select {
+	case <-idle:
+	case <-ctx.Done():
+		errs = append(errs, ctx.Err())
+		errs = append(errs, client.abortInFlight()...)
+		timer := time.NewTimer(shutdownAbortTimeout)
+		select {
+		case <-idle:
+		case <-timer.C:
+			client.discardInUse()
+		}
+		timer.Stop()
+	}
+	for _, p := range client.pools {
+		p.pool.Close()
+	}
+	return errors.Join(errs...)
+}
+
+// Aborts open transactions and sets a deadline on the connections in use, so that blocked operations fail.
+func (client *Client) abortInFlight() []error {
+	deadline := time.Now().Add(shutdownAbortTimeout)
+	client.mutex.Lock()
+	for c := range client.connections {
+		c.SetDeadline(deadline)
+	}
+	var open []*InteractiveTransaction
+	var unreachable []*connection
+	for t := range client.transactions {
+		if tx := t.tx.Value(); tx != nil {
+			open = append(open, tx)
+		} else {
+			// garbage collected, but its finalizer has not released the connection yet
+			unreachable = append(unreachable, t.con)
+		}
+	}
+	client.mutex.Unlock()
+	for _, c := range unreachable {
+		c.discard()
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(open))
+	for i, tx := range open {
+		wg.Add(1)
+		go func(i int, tx *InteractiveTransaction) {
+			defer wg.Done()
+			errs[i] = tx.shutdown()
+		}(i, tx)
+	}
+	wg.Wait()
+	return errs
+}
+
+// Closes the connections still in use, e.g. by operations that did not return after their deadline.
+func (client *Client) discardInUse() {
+	client.mutex.Lock()
+	inUse := make([]*connection, 0, len(client.connections))
+	for c := range client.connections {
+		inUse = append(inUse, c)
+	}
+	client.mutex.Unlock()
+	for _, c := range inUse {
+		c.discard()
+	}
+}
@@ -0,0 +1,118 @@
+package antidoteclient
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestShutdownWaitsForTransactions(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := client.NewWriteBatch(WriteBatchOptions{})
+	if err = (&Bucket{[]byte("bucket")}).Update(batch, CounterInc(Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error)
+	go func() { done <- client.Shutdown(context.Background()) }()
+	select {
+	case err = <-done:
+		t.Fatalf("shutdown returned before the transaction finished: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if n := len(server.received(MsgStaticUpdateObjects)); n != 1 {
+		t.Fatalf("expected buffered updates to be flushed, got %d static updates", n)
+	}
+	if _, err = client.StartTransaction(); !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("expected ErrClientClosed, got %v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestShutdownAbortsTransactions(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err = client.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if tx.State() != TxAborted {
+		t.Fatalf("unexpected state %s", tx.State())
+	}
+	if n := len(server.received(MsgAbortTransaction)); n != 1 {
+		t.Fatalf("expected the open transaction to be aborted, got %d aborts", n)
+	}
+	if len(client.Stats().OpenTransactions) != 0 {
+		t.Fatal("expected no open transactions after shutdown")
+	}
+}
+
+func TestShutdownReleasesUnreachableTransactions(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// finalizers run on a single goroutine, block it so that the finalizer of the transaction does not run
+	blocking, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	blocker := new([16]byte)
+	runtime.SetFinalizer(blocker, func(*[16]byte) {
+		close(blocking)
+		<-release
+	})
+	blocker = nil
+	runtime.GC()
+	<-blocking
+
+	if _, err = client.StartTransaction(); err != nil {
+		t.Fatal(err)
+	}
+	runtime.GC()
+	if len(client.Stats().OpenTransactions) != 1 {
+		t.Fatal("expected the unreachable transaction to be registered")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- client.Shutdown(ctx) }()
+	select {
+	case err = <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected deadline exceeded, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown did not return")
+	}
+	// the connection is released right away, without waiting for in-flight operations
+	if elapsed := time.Since(start); elapsed >= shutdownAbortTimeout {
+		t.Fatalf("shutdown took %s", elapsed)
+	}
+}
@@ -0,0 +1,117 @@
+package antidoteclient
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+	"weak"
+)
+
+// Maximum number of stack frames recorded when a transaction is started
+const maxStackDepth = 32
+
+// An interactive transaction registered with the client until committed or aborted
+type openTransaction struct {
+	// weak, so that leaked transactions can still be garbage collected
+	tx   weak.Pointer[InteractiveTransaction]
+	txID []byte
+	// connection of the transaction, released by Shutdown if the transaction is garbage collected before its finalizer ran
+	con     *connection
+	host    Host
+	started time.Time
+	stack   []uintptr
+}
+
+// Describes an interactive transaction that is neither committed nor aborted.
+type TransactionInfo struct {
+	// Descriptor of the transaction on the Antidote server
+	TransactionDescriptor []byte
+	// Server the transaction was started on
+	Host Host
+	// Time the transaction was started
+	Started time.Time
+	// Time since the transaction was started
+	Age time.Duration
+	// Stack trace of the goroutine that started the transaction
+	Stack string
+}
+
+// Statistics of a client, used to debug transactions that are not committed.
+type ClientStats struct {
+	// Open interactive transactions, oldest first
+	OpenTransactions []TransactionInfo
+	// Number of transactions aborted by the client after the maximum lifetime
+	ExpiredTransactions uint64
+	// Number of transactions garbage collected without commit or abort
+	LeakedTransactions uint64
+}
+
+// Returns statistics about the transactions of the client.
+func (client *Client) Stats() ClientStats {
+	client.mutex.Lock()
+	open := make([]*openTransaction, 0, len(client.transactions))
+	for t := range client.transactions {
+		open = append(open, t)
+	}
+	client.mutex.Unlock()
+	sort.Slice(open, func(i, j int) bool { return open[i].started.Before(open[j].started) })
+
+	now := time.Now()
+	stats := ClientStats{
+		OpenTransactions:    make([]TransactionInfo, len(open)),
+		ExpiredTransactions: atomic.LoadUint64(&client.expiredTransactions),
+		LeakedTransactions:  atomic.LoadUint64(&client.leakedTransactions),
+	}
+	for i, t := range open {
+		stats.OpenTransactions[i] = TransactionInfo{
+			TransactionDescriptor: t.txID,
+			Host:                  t.host,
+			Started:               t.started,
+			Age:                   now.Sub(t.started),
+			Stack:                 formatStack(t.stack),
+		}
+	}
+	return stats
+}
+
+// Registers an open transaction and records the stack leaving out the given number of callers.
+func (client *Client) addTransaction(tx *InteractiveTransaction, skip int) *openTransaction {
+	pcs := make([]uintptr, maxStackDepth)
+	// skip runtime.Callers and addTransaction
+	n := runtime.Callers(skip+2, pcs)
+	t := &openTransaction{
+		tx:      weak.Make(tx),
+		txID:    tx.txID,
+		con:     tx.con,
+		host:    tx.con.host.host,
+		started: time.Now(),
+		stack:   pcs[:n],
+	}
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.transactions[t] = struct{}{}
+	return t
+}
+
+func (client *Client) removeTransaction(t *openTransaction) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	delete(client.transactions, t)
+}
+
+// Formats a stack in the style of runtime/debug.Stack
+func formatStack(pcs []uintptr) string {
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&sb, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return sb.String()
+}
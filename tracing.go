@@ -0,0 +1,48 @@
+package antidoteclient
+
+import "context"
+
+// Receives the lifecycle of interactive transactions, e.g. to record them as spans of a distributed trace.
+// Implementations have to be safe for concurrent use; the oteltrace subpackage offers an implementation for OpenTelemetry.
+type Tracer interface {
+	// Called when an interactive transaction is started with the context passed to StartTransactionContext
+	StartTransaction(ctx context.Context) TransactionSpan
+}
+
+// Span covering an interactive transaction from its start to its commit or abort.
+type TransactionSpan interface {
+	// Called once the transaction is registered on the given host
+	Started(host Host, txID []byte)
+	// Called before a read or update of the given objects; the returned function is called with its result
+	StartOperation(op Operation, objects []*ApbBoundObject) (end func(err error))
+	// Called when the transaction ends with OpCommit, OpAbort or, if it could not be started, OpStartTransaction.
+	// The commit time is only set for successful commits.
+	End(op Operation, commitTime []byte, err error)
+}
+
+// Span used if no tracer is configured
+type noopTransactionSpan struct{}
+
+func (noopTransactionSpan) Started(host Host, txID []byte) {}
+
+func (noopTransactionSpan) StartOperation(op Operation, objects []*ApbBoundObject) func(err error) {
+	return func(err error) {}
+}
+
+func (noopTransactionSpan) End(op Operation, commitTime []byte, err error) {}
+
+func (client *Client) startTransactionSpan(ctx context.Context) TransactionSpan {
+	if client.tracer == nil {
+		return noopTransactionSpan{}
+	}
+	return client.tracer.StartTransaction(ctx)
+}
+
+// Returns the objects of the updates for tracing
+func boundObjects(updates []*ApbUpdateOp) []*ApbBoundObject {
+	objects := make([]*ApbBoundObject, len(updates))
+	for i, u := range updates {
+		objects[i] = u.Boundobject
+	}
+	return objects
+}
@@ -0,0 +1,68 @@
+package antidoteclient
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+type recordingTracer struct {
+	mutex  sync.Mutex
+	events []string
+}
+
+func (t *recordingTracer) record(event string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.events = append(t.events, event)
+}
+
+func (t *recordingTracer) StartTransaction(ctx context.Context) TransactionSpan {
+	t.record("start:" + ctx.Value("request").(string))
+	return t
+}
+
+func (t *recordingTracer) Started(host Host, txID []byte) {
+	t.record("started:" + string(txID))
+}
+
+func (t *recordingTracer) StartOperation(op Operation, objects []*ApbBoundObject) func(err error) {
+	t.record(string(op) + ":" + string(objects[0].Key))
+	return func(err error) { t.record("end " + string(op)) }
+}
+
+func (t *recordingTracer) End(op Operation, commitTime []byte, err error) {
+	t.record(string(op) + ":" + string(commitTime))
+}
+
+func TestTracer(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	tracer := &recordingTracer{}
+	client, err := NewClientWithOptions(ClientOptions{Tracer: tracer}, server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	tx, err := client.StartTransactionContext(context.WithValue(context.Background(), "request", "r1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bucket := Bucket{[]byte("bucket")}
+	if err = bucket.Update(tx, CounterInc(Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"start:r1", "started:tx", "update:a", "end update", "commit:ct"}
+	if len(tracer.events) != len(expected) {
+		t.Fatalf("unexpected events: %v", tracer.events)
+	}
+	for i, e := range expected {
+		if tracer.events[i] != e {
+			t.Fatalf("unexpected events: %v", tracer.events)
+		}
+	}
+}
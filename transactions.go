@@ -1,8 +1,16 @@
 package antidoteclient
 
 import (
-	"bytes"
+	"context"
+	"encoding/hex"
 	"fmt"
+	"log/slog"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // Represents a bucket in the Antidote database.
@@ -37,150 +45,392 @@ type CRDTReader interface {
 	ReadCounter(tx Transaction, key Key) (val int32, err error)
 }
 
+// A FlagReader allows to read the value of enable-wins flags in the context of a transaction.
+// Kept apart from CRDTReader so that existing implementations of CRDTReader remain valid.
+type FlagReader interface {
+	// Read the value of an enable-wins flag identified by the given key
+	ReadFlag(tx Transaction, key Key) (val bool, err error)
+}
+
+// State in the lifecycle of an interactive transaction
+type TxState int
+
+const (
+	// The transaction is started and accepts reads and updates
+	TxActive TxState = iota
+	// The transaction was committed successfully
+	TxCommitted
+	// The transaction was aborted
+	TxAborted
+	// The connection of the transaction broke or Antidote rejected the commit
+	TxFailed
+	// The transaction exceeded the maximum lifetime and was aborted by the client
+	TxExpired
+)
+
+func (s TxState) String() string {
+	switch s {
+	case TxActive:
+		return "active"
+	case TxCommitted:
+		return "committed"
+	case TxAborted:
+		return "aborted"
+	case TxFailed:
+		return "failed"
+	case TxExpired:
+		return "expired"
+	}
+	return fmt.Sprintf("TxState(%d)", int(s))
+}
+
 // A transaction handled by Antidote on the server side.
 // Interactive Transactions need to be started on the server and are kept open for their duration.
 // Update operations are only visible to reads issued in the context of the same transaction or after committing the transaction.
 // Always commit or abort interactive transactions to clean up the server side!
+// Once committed, aborted or failed, operations on the transaction return a *TxDoneError.
+// Interactive transactions are safe for concurrent use; requests are serialized on the connection of the transaction.
 type InteractiveTransaction struct {
-	txID      []byte
-	con       *connection
-	committed bool
+	txID   []byte
+	con    *connection
+	client *Client
+	span   TransactionSpan
+	// guards the connection and the state
+	mutex sync.Mutex
+	state TxState
+	// entry in the open transactions of the client
+	open *openTransaction
+	// aborts the transaction after the maximum lifetime, nil if unlimited
+	timer *time.Timer
+	// objects updated in this transaction, reported to the client on commit
+	updated []*ApbBoundObject
+}
+
+func newInteractiveTransaction(client *Client, con *connection, txID []byte, span TransactionSpan) *InteractiveTransaction {
+	tx := &InteractiveTransaction{
+		con:    con,
+		txID:   txID,
+		client: client,
+		span:   span,
+	}
+	// the stack starts at the caller of StartTransactionContext, skipping startTransaction
+	tx.open = client.addTransaction(tx, 3)
+	runtime.SetFinalizer(tx, (*InteractiveTransaction).leaked)
+	if client.maxTxLifetime > 0 {
+		tx.timer = time.AfterFunc(client.maxTxLifetime, tx.expire)
+	}
+	return tx
+}
+
+// Called when the transaction is garbage collected.
+// Reports transactions that were neither committed nor aborted and releases their connection.
+func (tx *InteractiveTransaction) leaked() {
+	if tx.state != TxActive {
+		return
+	}
+	atomic.AddUint64(&tx.client.leakedTransactions, 1)
+	tx.client.warn("transaction garbage collected without commit or abort", slog.String("tx", hex.EncodeToString(tx.txID)))
+	tx.finish(TxFailed)
+	tx.span.End(OpAbort, nil, ErrTxLeaked)
+}
+
+// Called when the transaction exceeds the maximum lifetime.
+// Aborts the transaction on the server and returns the connection to the pool.
+func (tx *InteractiveTransaction) expire() {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if tx.state != TxActive {
+		return
+	}
+	atomic.AddUint64(&tx.client.expiredTransactions, 1)
+	tx.client.warn("transaction exceeded maximum lifetime, aborting",
+		slog.String("tx", hex.EncodeToString(tx.txID)), slog.Duration("lifetime", tx.client.maxTxLifetime))
+	tx.abort(ErrTxExpired)
+	tx.state = TxExpired
+}
+
+// Returns the current state of the transaction
+func (tx *InteractiveTransaction) State() TxState {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	return tx.state
 }
 
-func (tx *InteractiveTransaction) Update(updates ...*ApbUpdateOp) error {
+// Returns an error if the transaction does not accept operations anymore.
+// The caller must hold the mutex of the transaction.
+func (tx *InteractiveTransaction) checkActive() error {
+	if tx.state != TxActive {
+		return &TxDoneError{State: tx.state}
+	}
+	return nil
+}
+
+// Ends the transaction in the given state and stops watching it for leaks.
+// Failed transactions discard their connection, as it may still carry an unread response.
+func (tx *InteractiveTransaction) finish(state TxState) error {
+	tx.state = state
+	runtime.SetFinalizer(tx, nil)
+	if tx.timer != nil {
+		tx.timer.Stop()
+	}
+	tx.client.removeTransaction(tx.open)
+	if state == TxFailed {
+		return tx.con.discard()
+	}
+	return tx.con.Close()
+}
+
+// Sends the request over the connection of the transaction.
+// Fails the transaction if the connection broke or the context is done before the response arrived;
+// Antidote's error responses leave it active.
+func (tx *InteractiveTransaction) roundTrip(ctx context.Context, req proto.Message, resp proto.Message) error {
+	err := roundTripContext(ctx, tx.con, req, resp)
+	if err != nil {
+		if _, ok := err.(*ServerError); !ok {
+			tx.finish(TxFailed)
+			tx.span.End(OpAbort, nil, err)
+		}
+	}
+	return err
+}
+
+func (tx *InteractiveTransaction) Update(updates ...*ApbUpdateOp) (err error) {
+	return tx.UpdateContext(context.Background(), updates...)
+}
+
+// Issues the updates like Update. If the context is done before Antidote answers,
+// the update is interrupted and the transaction fails with the error of the context.
+func (tx *InteractiveTransaction) UpdateContext(ctx context.Context, updates ...*ApbUpdateOp) (err error) {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if err = tx.checkActive(); err != nil {
+		return
+	}
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	defer func(start time.Time) { tx.client.observe(OpUpdate, tx.con, start, err) }(time.Now())
+	end := tx.span.StartOperation(OpUpdate, boundObjects(updates))
+	defer func() { end(err) }()
 	apbUpdate := &ApbUpdateObjects{
 		Updates:               updates,
 		TransactionDescriptor: tx.txID,
 	}
-	err := apbUpdate.encode(tx.con)
+	resp := &ApbOperationResp{}
+	err = tx.roundTrip(ctx, apbUpdate, resp)
 	if err != nil {
 		return err
 	}
-	resp, err := decodeOperationResp(tx.con)
-	if err != nil {
-		return err
+	if !resp.GetSuccess() {
+		return &ServerError{Code: resp.GetErrorcode()}
 	}
-	if !(*resp.Success) {
-		return fmt.Errorf("operation not successful; error code %d", *resp.Errorcode)
+	for _, u := range updates {
+		tx.updated = append(tx.updated, u.Boundobject)
 	}
 	return nil
 }
 
 func (tx *InteractiveTransaction) Read(objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	return tx.ReadContext(context.Background(), objects...)
+}
+
+// Reads the objects like Read. If the context is done before Antidote answers,
+// the read is interrupted and the transaction fails with the error of the context.
+func (tx *InteractiveTransaction) ReadContext(ctx context.Context, objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if err = tx.checkActive(); err != nil {
+		return
+	}
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	defer func(start time.Time) { tx.client.observe(OpRead, tx.con, start, err) }(time.Now())
+	end := tx.span.StartOperation(OpRead, objects)
+	defer func() { end(err) }()
 	apbUpdate := &ApbReadObjects{
 		TransactionDescriptor: tx.txID,
 		Boundobjects:          objects,
 	}
-	err = apbUpdate.encode(tx.con)
+	resp = &ApbReadObjectsResp{}
+	err = tx.roundTrip(ctx, apbUpdate, resp)
 	if err != nil {
-		return
+		return nil, err
+	}
+	if !resp.GetSuccess() {
+		return nil, &ServerError{Code: resp.GetErrorcode()}
 	}
-	return decodeReadObjectsResp(tx.con)
+	return
 }
 
 // commits the transaction, makes the updates issued under this transaction visible to subsequent transaction
 // and cleans up the server side.
-func (tx *InteractiveTransaction) Commit() error {
-	if !tx.committed {
-		msg := &ApbCommitTransaction{TransactionDescriptor: tx.txID}
-		err := msg.encode(tx.con)
-		if err != nil {
-			return err
-		}
-		op, err := decodeCommitResp(tx.con)
-		if err != nil {
-			return err
-		}
-		err = tx.con.Close()
-		if err != nil {
-			return err
-		}
-		if !(*op.Success) {
-			return fmt.Errorf("operation not successful; error code %d", *op.Errorcode)
-		}
+// The transaction is failed if Antidote does not commit it.
+func (tx *InteractiveTransaction) Commit() (err error) {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if err = tx.checkActive(); err != nil {
+		return
 	}
-	return nil
+	defer func(start time.Time) { tx.client.observe(OpCommit, tx.con, start, err) }(time.Now())
+	msg := &ApbCommitTransaction{TransactionDescriptor: tx.txID}
+	op := &ApbCommitResp{}
+	err = roundTrip(tx.con, msg, op)
+	if err == nil && !op.GetSuccess() {
+		err = &ServerError{Code: op.GetErrorcode()}
+	}
+	if err != nil {
+		tx.finish(TxFailed)
+		tx.span.End(OpCommit, nil, err)
+		return err
+	}
+	err = tx.finish(TxCommitted)
+	tx.span.End(OpCommit, op.CommitTime, err)
+	tx.client.committed(tx.updated)
+	tx.client.debug("transaction committed", slog.String("tx", hex.EncodeToString(tx.txID)))
+	return
 }
 
 // aborts the transactions, discards updates issued under this transaction
 // and cleans up the server side.
 // WARNING: May not be supported by the current version of Antidote
-func (tx *InteractiveTransaction) Abort() error {
-	if !tx.committed {
-		msg := &ApbAbortTransaction{TransactionDescriptor: tx.txID}
-		err := msg.encode(tx.con)
-		if err != nil {
-			return err
-		}
-		op, err := decodeOperationResp(tx.con)
-		if err != nil {
-			return err
-		}
-		err = tx.con.Close()
-		if err != nil {
+func (tx *InteractiveTransaction) Abort() (err error) {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if err = tx.checkActive(); err != nil {
+		return
+	}
+	return tx.abort(nil)
+}
+
+// Aborts the transaction; the reason is recorded in the span of the transaction.
+// The caller must hold the mutex of the transaction.
+func (tx *InteractiveTransaction) abort(reason error) (err error) {
+	defer func(start time.Time) { tx.client.observe(OpAbort, tx.con, start, err) }(time.Now())
+	msg := &ApbAbortTransaction{TransactionDescriptor: tx.txID}
+	op := &ApbOperationResp{}
+	err = roundTrip(tx.con, msg, op)
+	if err != nil {
+		if _, ok := err.(*ServerError); !ok {
+			tx.finish(TxFailed)
+			tx.span.End(OpAbort, nil, err)
 			return err
 		}
-		if !(*op.Success) {
-			return fmt.Errorf("operation not successful; error code %d", *op.Errorcode)
-		}
+	} else if !op.GetSuccess() {
+		err = &ServerError{Code: op.GetErrorcode()}
 	}
-	return nil
+	// the transaction is discarded on the client side even if Antidote reports an error
+	if cerr := tx.finish(TxAborted); err == nil {
+		err = cerr
+	}
+	if reason == nil {
+		reason = err
+	}
+	tx.span.End(OpAbort, nil, reason)
+	tx.client.debug("transaction aborted", slog.String("tx", hex.EncodeToString(tx.txID)))
+	return
+}
+
+// Aborts the transaction on shutdown of the client, unless it ended in the meantime.
+func (tx *InteractiveTransaction) shutdown() error {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+	if tx.state != TxActive {
+		return nil
+	}
+	return tx.abort(ErrClientClosed)
 }
 
 // Pseudo transaction to issue reads and updated without starting an interactive transaction.
 // Can be interpreted as starting a transaction for each read or update and directly committing it.
 type StaticTransaction struct {
 	client *Client
+	// pool of the host the transaction is executed on, nil for a random host
+	pool *hostPool
 }
 
 func (tx *StaticTransaction) Update(updates ...*ApbUpdateOp) error {
+	return tx.UpdateContext(context.Background(), updates...)
+}
+
+// Issues the updates like Update. If the context is done before Antidote answers,
+// the update is interrupted and fails with the error of the context; it may still have been applied.
+func (tx *StaticTransaction) UpdateContext(ctx context.Context, updates ...*ApbUpdateOp) error {
+	_, err := tx.update(ctx, updates...)
+	return err
+}
+
+// Issues the updates and returns the commit time of the transaction.
+func (tx *StaticTransaction) update(ctx context.Context, updates ...*ApbUpdateOp) (commitTime []byte, err error) {
+	var con *connection
+	defer func(start time.Time) { tx.client.observe(OpStaticUpdate, con, start, err) }(time.Now())
 	apbStaticUpdate := &ApbStaticUpdateObjects{
 		Transaction: &ApbStartTransaction{Properties: &ApbTxnProperties{}},
 		Updates:     updates,
 	}
-	con, err := tx.client.getConnection()
-	if err != nil {
-		return err
+	if err = ctx.Err(); err != nil {
+		return
 	}
-	err = apbStaticUpdate.encode(con)
+	con, err = tx.client.connectTo(tx.pool)
 	if err != nil {
-		return err
+		return
 	}
-	resp, err := decodeCommitResp(con)
+	resp := &ApbCommitResp{}
+	err = roundTripContext(ctx, con, apbStaticUpdate, resp)
 	if err != nil {
-		return err
+		con.discard()
+		return
 	}
 	err = con.Close()
 	if err != nil {
-		return err
+		return
 	}
 	if !(*resp.Success) {
-		return fmt.Errorf("operation not successful; error code %d", *resp.Errorcode)
+		err = &ServerError{Code: resp.GetErrorcode()}
+		return
 	}
-	return nil
+	tx.client.committed(boundObjects(updates))
+	return resp.CommitTime, nil
 }
 
 func (tx *StaticTransaction) Read(objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	return tx.read(context.Background(), nil, objects...)
+}
+
+// Reads the objects like Read. If the context is done before Antidote answers,
+// the read is interrupted and fails with the error of the context.
+func (tx *StaticTransaction) ReadContext(ctx context.Context, objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	return tx.read(ctx, nil, objects...)
+}
+
+// Reads the objects in a snapshot including the given commit time; nil reads the latest snapshot of the host.
+func (tx *StaticTransaction) read(ctx context.Context, clock []byte, objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	var con *connection
+	defer func(start time.Time) { tx.client.observe(OpStaticRead, con, start, err) }(time.Now())
 	apbRead := &ApbStaticReadObjects{
-		Transaction: &ApbStartTransaction{Properties: &ApbTxnProperties{}},
+		Transaction: &ApbStartTransaction{Properties: &ApbTxnProperties{}, Timestamp: clock},
 		Objects:     objects,
 	}
-	con, err := tx.client.getConnection()
-	if err != nil {
+	if err = ctx.Err(); err != nil {
 		return
 	}
-	err = apbRead.encode(con)
+	con, err = tx.client.connectTo(tx.pool)
 	if err != nil {
 		return
 	}
-	sresp, err := decodeStaticReadObjectsResp(con)
+	sresp := &ApbStaticReadObjectsResp{}
+	err = roundTripContext(ctx, con, apbRead, sresp)
 	if err != nil {
+		con.discard()
 		return
 	}
 	err = con.Close()
 	if err != nil {
 		return
 	}
+	if !sresp.Objects.GetSuccess() {
+		return nil, &ServerError{Code: sresp.Objects.GetErrorcode()}
+	}
 	return sresp.Objects, nil
 }
 
@@ -210,7 +460,7 @@ func (bucket *Bucket) ReadMap(tx Transaction, key Key) (val *MapReadResult, err
 	if err != nil {
 		return
 	}
-	val = &MapReadResult{mapResp: resp.Objects[0].Map}
+	val = newMapReadResult(resp.Objects[0].Map)
 	return
 }
 
@@ -234,62 +484,97 @@ func (bucket *Bucket) ReadCounter(tx Transaction, key Key) (val int32, err error
 	return
 }
 
+func (bucket *Bucket) ReadFlag(tx Transaction, key Key) (val bool, err error) {
+	crdtType := CRDTType_FLAG_EW
+	resp, err := tx.Read(&ApbBoundObject{Bucket: bucket.Bucket, Key: key, Type: &crdtType})
+	if err != nil {
+		return
+	}
+	val = *resp.Objects[0].Flag.Value
+	return
+}
+
 // Represents the result of reading from a map object.
 // Grants access to the keys of the map to access values of the nested CRDTs.
 type MapReadResult struct {
 	mapResp *ApbGetMapResp
+	// entries by key, built when the result is created and read-only afterwards
+	index map[string][]*ApbMapEntry
+}
+
+// Creates the result of reading a map and indexes its entries by key.
+func newMapReadResult(resp *ApbGetMapResp) *MapReadResult {
+	index := make(map[string][]*ApbMapEntry, len(resp.Entries))
+	for _, me := range resp.Entries {
+		k := string(me.Key.Key)
+		index[k] = append(index[k], me)
+	}
+	return &MapReadResult{mapResp: resp, index: index}
+}
+
+// Returns the entries with the given key, one per CRDT type.
+func (mrr *MapReadResult) entries(key Key) []*ApbMapEntry {
+	return mrr.index[string(key)]
+}
+
+// Looks up the entry with the given key and type.
+func (mrr *MapReadResult) entry(key Key, crdtType CRDTType) *ApbMapEntry {
+	for _, me := range mrr.entries(key) {
+		if *me.Key.Type == crdtType {
+			return me
+		}
+	}
+	return nil
 }
 
 // Access the value of the nested add-wins set under the given key
 func (mrr *MapReadResult) Set(key Key) (val [][]byte, err error) {
-	for _, me := range mrr.mapResp.Entries {
-		if *me.Key.Type == CRDTType_ORSET && bytes.Equal(me.Key.Key, key) {
-			return me.Value.Set.Value, nil
-		}
+	if me := mrr.entry(key, CRDTType_ORSET); me != nil {
+		return me.Value.Set.Value, nil
 	}
 	return nil, fmt.Errorf("set entry with key '%s' not found", key)
 }
 
 // Access the value of the nested last-writer-wins register under the given key
 func (mrr *MapReadResult) Reg(key Key) (val []byte, err error) {
-	for _, me := range mrr.mapResp.Entries {
-		if *me.Key.Type == CRDTType_LWWREG && bytes.Equal(me.Key.Key, key) {
-			return me.Value.Reg.Value, nil
-		}
+	if me := mrr.entry(key, CRDTType_LWWREG); me != nil {
+		return me.Value.Reg.Value, nil
 	}
 	return nil, fmt.Errorf("register entry with key '%s' not found", key)
 }
 
 // Access the value of the nested add-wins map under the given key
 func (mrr *MapReadResult) Map(key Key) (val *MapReadResult, err error) {
-	for _, me := range mrr.mapResp.Entries {
-		if *me.Key.Type == CRDTType_RRMAP && bytes.Equal(me.Key.Key, key) {
-			return &MapReadResult{mapResp: me.Value.Map}, nil
-		}
+	if me := mrr.entry(key, CRDTType_RRMAP); me != nil {
+		return newMapReadResult(me.Value.Map), nil
 	}
 	return nil, fmt.Errorf("map entry with key '%s' not found", key)
 }
 
 // Access the value of the nested multi-value register under the given key
 func (mrr *MapReadResult) MVReg(key Key) (val [][]byte, err error) {
-	for _, me := range mrr.mapResp.Entries {
-		if *me.Key.Type == CRDTType_MVREG && bytes.Equal(me.Key.Key, key) {
-			return me.Value.Mvreg.Values, nil
-		}
+	if me := mrr.entry(key, CRDTType_MVREG); me != nil {
+		return me.Value.Mvreg.Values, nil
 	}
 	return nil, fmt.Errorf("map entry with key '%s' not found", key)
 }
 
 // Access the value of the nested counter under the given key
 func (mrr *MapReadResult) Counter(key Key) (val int32, err error) {
-	for _, me := range mrr.mapResp.Entries {
-		if *me.Key.Type == CRDTType_COUNTER && bytes.Equal(me.Key.Key, key) {
-			return *me.Value.Counter.Value, nil
-		}
+	if me := mrr.entry(key, CRDTType_COUNTER); me != nil {
+		return *me.Value.Counter.Value, nil
 	}
 	return 0, fmt.Errorf("counter entry with key '%s' not found", key)
 }
 
+// Access the value of the nested enable-wins flag under the given key
+func (mrr *MapReadResult) Flag(key Key) (val bool, err error) {
+	if me := mrr.entry(key, CRDTType_FLAG_EW); me != nil {
+		return *me.Value.Flag.Value, nil
+	}
+	return false, fmt.Errorf("flag entry with key '%s' not found", key)
+}
+
 // MapEntryKey represents the key and type of a map entry (embedded CRDT).
 type MapEntryKey struct {
 	Key      []byte
@@ -409,6 +694,17 @@ func MVRegPut(key Key, value []byte) *CRDTUpdate {
 	}
 }
 
+// Represents the update to enable or disable an enable-wins flag
+func FlagPut(key Key, value bool) *CRDTUpdate {
+	return &CRDTUpdate{
+		Key:  key,
+		Type: CRDTType_FLAG_EW,
+		Update: &ApbUpdateOperation{
+			Flagop: &ApbFlagUpdate{Value: &value},
+		},
+	}
+}
+
 // Represents the update to nested objects of an add-wins map
 func MapUpdate(key Key, updates ...*CRDTUpdate) *CRDTUpdate {
 	nupdates := make([]*ApbMapNestedUpdate, len(updates))
@@ -423,3 +719,19 @@ func MapUpdate(key Key, updates ...*CRDTUpdate) *CRDTUpdate {
 		},
 	}
 }
+
+// Represents the update to remove nested objects from an add-wins map
+func MapRemove(key Key, keys ...MapEntryKey) *CRDTUpdate {
+	removed := make([]*ApbMapKey, len(keys))
+	for i, k := range keys {
+		crdtType := k.CrdtType
+		removed[i] = &ApbMapKey{Key: k.Key, Type: &crdtType}
+	}
+	return &CRDTUpdate{
+		Key:  key,
+		Type: CRDTType_RRMAP,
+		Update: &ApbUpdateOperation{
+			Mapop: &ApbMapUpdate{RemovedKeys: removed},
+		},
+	}
+}
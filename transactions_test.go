@@ -0,0 +1,298 @@
+package antidoteclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTransactionLifecycle(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	bucket := Bucket{[]byte("bucket")}
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = bucket.Update(tx, CounterInc(Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if tx.State() != TxCommitted {
+		t.Fatalf("unexpected state %s", tx.State())
+	}
+	if err = tx.Commit(); !errors.Is(err, ErrTxDone) {
+		t.Fatalf("expected ErrTxDone on second commit, got %v", err)
+	}
+	if err = tx.Abort(); !errors.Is(err, ErrTxDone) {
+		t.Fatalf("expected ErrTxDone on abort after commit, got %v", err)
+	}
+	if _, err = bucket.ReadCounter(tx, Key("a")); !errors.Is(err, ErrTxDone) {
+		t.Fatalf("expected ErrTxDone on read after commit, got %v", err)
+	}
+	if n := len(server.received(MsgCommitTransaction)); n != 1 {
+		t.Fatalf("expected a single commit to be sent, got %d", n)
+	}
+
+	tx, err = client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Abort(); err != nil {
+		t.Fatal(err)
+	}
+	err = bucket.Update(tx, CounterInc(Key("a"), 1))
+	var doneErr *TxDoneError
+	if !errors.As(err, &doneErr) || doneErr.State != TxAborted {
+		t.Fatalf("expected TxDoneError for aborted transaction, got %v", err)
+	}
+}
+
+func TestTransactionFailed(t *testing.T) {
+	server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		if code == MsgReadObjects {
+			// closes the connection
+			return 0, nil
+		}
+		return ackHandler(code, data)
+	})
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bucket := Bucket{[]byte("bucket")}
+	if _, err = bucket.ReadCounter(tx, Key("a")); err == nil {
+		t.Fatal("expected read to fail")
+	}
+	if tx.State() != TxFailed {
+		t.Fatalf("unexpected state %s", tx.State())
+	}
+	if err = tx.Commit(); !errors.Is(err, ErrTxDone) {
+		t.Fatalf("expected ErrTxDone on commit of failed transaction, got %v", err)
+	}
+}
+
+func TestTransactionContext(t *testing.T) {
+	unblocked := make(chan struct{})
+	defer close(unblocked)
+	server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		if code == MsgReadObjects || code == MsgStaticReadObjects {
+			<-unblocked
+		}
+		return ackHandler(code, data)
+	})
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	object := &ApbBoundObject{Bucket: []byte("bucket"), Key: Key("a"), Type: CRDTType_COUNTER.Enum()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err = client.CreateStaticTransaction().ReadContext(ctx, object); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err = tx.ReadContext(ctx, object); !errors.Is(err, context.Canceled) || tx.State() != TxActive {
+		t.Fatalf("expected read with done context to leave the transaction active, got %v in state %s", err, tx.State())
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err = tx.ReadContext(ctx, object); !errors.Is(err, context.DeadlineExceeded) || tx.State() != TxFailed {
+		t.Fatalf("expected interrupted read to fail the transaction, got %v in state %s", err, tx.State())
+	}
+}
+
+func TestTransactionLeakDetection(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	out := &syncBuffer{}
+	client, err := NewClientWithOptions(ClientOptions{Logger: slog.New(slog.NewTextHandler(out, nil))}, server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if _, err = client.StartTransaction(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100 && !strings.Contains(out.String(), "transaction garbage collected"); i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !strings.Contains(out.String(), "transaction garbage collected without commit or abort") {
+		t.Fatalf("expected leaked transaction to be reported, log:\n%s", out.String())
+	}
+	if stats := client.Stats(); stats.LeakedTransactions != 1 || len(stats.OpenTransactions) != 0 {
+		t.Fatalf("unexpected stats after leak: %+v", stats)
+	}
+}
+
+// Answers reads with registers holding the keys of the requested objects
+func echoReadHandler(code MessageCode, data []byte) (MessageCode, proto.Message) {
+	if code != MsgReadObjects {
+		return ackHandler(code, data)
+	}
+	req := &ApbReadObjects{}
+	proto.Unmarshal(data, req)
+	success := true
+	objects := make([]*ApbReadObjectResp, len(req.Boundobjects))
+	for i, o := range req.Boundobjects {
+		objects[i] = &ApbReadObjectResp{Reg: &ApbGetRegResp{Value: o.Key}}
+	}
+	return MsgReadObjectsResp, &ApbReadObjectsResp{Success: &success, Objects: objects}
+}
+
+func TestTransactionConcurrentUse(t *testing.T) {
+	server := newFakeServer(t, echoReadHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	bucket := Bucket{[]byte("bucket")}
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const goroutines, ops = 8, 50
+	errs := make(chan error, goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			for i := 0; i < ops; i++ {
+				key := Key(fmt.Sprintf("key-%d-%d", g, i))
+				if err := bucket.Update(tx, RegPut(key, key)); err != nil {
+					errs <- err
+					return
+				}
+				val, err := bucket.ReadReg(tx, key)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if string(val) != string(key) {
+					errs <- fmt.Errorf("read %q for key %q", val, key)
+					return
+				}
+			}
+			errs <- nil
+		}(g)
+	}
+	for g := 0; g < goroutines; g++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(server.received(MsgReadObjects)); n != goroutines*ops {
+		t.Fatalf("expected %d reads, got %d", goroutines*ops, n)
+	}
+}
+
+func TestTransactionConcurrentCommit(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const goroutines = 8
+	errs := make(chan error, goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func() {
+			if g%2 == 0 {
+				errs <- tx.Commit()
+			} else {
+				errs <- tx.Abort()
+			}
+		}()
+	}
+	succeeded := 0
+	for g := 0; g < goroutines; g++ {
+		err := <-errs
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, ErrTxDone):
+			t.Fatal(err)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("expected exactly one commit or abort to succeed, got %d", succeeded)
+	}
+	if n := len(server.received(MsgCommitTransaction)) + len(server.received(MsgAbortTransaction)); n != 1 {
+		t.Fatalf("expected a single commit or abort to be sent, got %d", n)
+	}
+}
+
+func TestTransactionLifetime(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClientWithOptions(ClientOptions{MaxTransactionLifetime: 50 * time.Millisecond}, server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	tx, err := client.StartTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := client.Stats()
+	if len(stats.OpenTransactions) != 1 {
+		t.Fatalf("expected one open transaction, got %+v", stats)
+	}
+	if open := stats.OpenTransactions[0]; string(open.TransactionDescriptor) != "tx" || !strings.Contains(open.Stack, "TestTransactionLifetime") {
+		t.Fatalf("unexpected transaction info %+v", open)
+	}
+
+	for i := 0; i < 100 && tx.State() == TxActive; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if tx.State() != TxExpired {
+		t.Fatalf("unexpected state %s", tx.State())
+	}
+	if _, err = (&Bucket{[]byte("bucket")}).ReadCounter(tx, Key("a")); !errors.Is(err, ErrTxExpired) || !errors.Is(err, ErrTxDone) {
+		t.Fatalf("expected ErrTxExpired, got %v", err)
+	}
+	if n := len(server.received(MsgAbortTransaction)); n != 1 {
+		t.Fatalf("expected the expired transaction to be aborted, got %d aborts", n)
+	}
+	stats = client.Stats()
+	if len(stats.OpenTransactions) != 0 || stats.ExpiredTransactions != 1 {
+		t.Fatalf("unexpected stats after expiry: %+v", stats)
+	}
+}
@@ -0,0 +1,243 @@
+package antidoteclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Determines what an UpdateQueue does when its buffer is full.
+type BackpressurePolicy int
+
+const (
+	// Block the caller until there is space in the buffer
+	BackpressureBlock BackpressurePolicy = iota
+	// Discard the update; its future completes with ErrUpdateDropped.
+	// A callback given with SubmitCallback is invoked from a worker goroutine, like for updates sent
+	BackpressureDrop
+	// Reject the update with ErrQueueFull
+	BackpressureError
+)
+
+// Returned when submitting to a full queue with BackpressureError
+var ErrQueueFull = errors.New("update queue is full")
+
+// Returned when submitting to a closed queue
+var ErrQueueClosed = errors.New("update queue is closed")
+
+// Result of updates discarded by a full queue with BackpressureDrop
+var ErrUpdateDropped = errors.New("update dropped, queue is full")
+
+// Configures buffering and concurrency of an UpdateQueue.
+type UpdateQueueOptions struct {
+	// Number of updates that can be buffered; defaults to 1000
+	QueueSize int
+	// Number of goroutines sending updates to Antidote; defaults to 1
+	Workers int
+	// Behaviour when the buffer is full; defaults to BackpressureBlock
+	Policy BackpressurePolicy
+}
+
+// Pending result of updates submitted to an UpdateQueue.
+type UpdateFuture struct {
+	done     chan struct{}
+	callback func(commitTime []byte, err error)
+	// not handed out to the caller, failures are reported by UpdateQueue.Close instead
+	unobserved bool
+	commitTime []byte
+	err        error
+}
+
+// Closed as soon as the updates are committed or failed
+func (f *UpdateFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Waits for the updates to be committed and returns the commit time of the static transaction
+func (f *UpdateFuture) Wait() (commitTime []byte, err error) {
+	<-f.done
+	return f.commitTime, f.err
+}
+
+func (f *UpdateFuture) complete(commitTime []byte, err error) {
+	f.resolve(commitTime, err)
+	f.call()
+}
+
+func (f *UpdateFuture) resolve(commitTime []byte, err error) {
+	f.commitTime = commitTime
+	f.err = err
+	close(f.done)
+}
+
+func (f *UpdateFuture) call() {
+	if f.callback != nil {
+		f.callback(f.commitTime, f.err)
+	}
+}
+
+type queuedUpdate struct {
+	updates []*ApbUpdateOp
+	future  *UpdateFuture
+}
+
+// Sends updates to Antidote asynchronously, each submission as one static transaction.
+// Updates are buffered and sent by a pool of worker goroutines;
+// with more than one worker, submissions may be committed in a different order.
+// Closing the queue, or the client it was created from, waits until all buffered updates are sent.
+type UpdateQueue struct {
+	client  *Client
+	tx      *StaticTransaction
+	options UpdateQueueOptions
+	jobs    chan *queuedUpdate
+	workers sync.WaitGroup
+
+	// held for reading while submitting, for writing while closing
+	closeLock sync.RWMutex
+	closed    bool
+
+	// futures of dropped updates whose callbacks are still to be invoked by a worker
+	droppedLock sync.Mutex
+	dropped     []*UpdateFuture
+
+	// first failure of updates submitted with Update, returned by Close
+	failureLock sync.Mutex
+	failure     error
+}
+
+// Creates a queue sending updates asynchronously with static transactions of this client.
+func (client *Client) NewUpdateQueue(options UpdateQueueOptions) *UpdateQueue {
+	if options.QueueSize <= 0 {
+		options.QueueSize = 1000
+	}
+	if options.Workers <= 0 {
+		options.Workers = 1
+	}
+	queue := &UpdateQueue{
+		client:  client,
+		tx:      client.CreateStaticTransaction(),
+		options: options,
+		jobs:    make(chan *queuedUpdate, options.QueueSize),
+	}
+	queue.workers.Add(options.Workers)
+	for i := 0; i < options.Workers; i++ {
+		go queue.work()
+	}
+	client.addQueue(queue)
+	return queue
+}
+
+// Submits updates to be sent asynchronously.
+// The returned future completes with the commit time once the updates are committed.
+func (queue *UpdateQueue) Submit(updates ...*ApbUpdateOp) (*UpdateFuture, error) {
+	return queue.submit(nil, false, updates)
+}
+
+// Submits updates to be sent asynchronously.
+// The callback is invoked from a worker goroutine once the updates are committed or failed.
+func (queue *UpdateQueue) SubmitCallback(callback func(commitTime []byte, err error), updates ...*ApbUpdateOp) error {
+	_, err := queue.submit(callback, false, updates)
+	return err
+}
+
+// Submits updates without waiting for their result.
+// Together with Read, this allows to use the queue as a Transaction with Bucket.Update.
+// Only errors of submitting are returned: the updates are sent later, and whether they were committed is only reported
+// by the future returned by Submit. Close returns the first failure of updates submitted with Update.
+func (queue *UpdateQueue) Update(updates ...*ApbUpdateOp) error {
+	_, err := queue.submit(nil, true, updates)
+	return err
+}
+
+// Reads the objects with a static transaction.
+// Reads do not wait for buffered updates.
+func (queue *UpdateQueue) Read(objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	return queue.tx.Read(objects...)
+}
+
+// Stops accepting updates and waits until all buffered updates are sent.
+// Returns the first failure of updates submitted with Update, whose errors are not reported otherwise.
+func (queue *UpdateQueue) Close() error {
+	queue.closeLock.Lock()
+	if queue.closed {
+		queue.closeLock.Unlock()
+		return nil
+	}
+	queue.closed = true
+	close(queue.jobs)
+	queue.closeLock.Unlock()
+	queue.workers.Wait()
+	queue.client.removeQueue(queue)
+	queue.failureLock.Lock()
+	defer queue.failureLock.Unlock()
+	return queue.failure
+}
+
+func (queue *UpdateQueue) submit(callback func(commitTime []byte, err error), unobserved bool, updates []*ApbUpdateOp) (*UpdateFuture, error) {
+	job := &queuedUpdate{
+		updates: updates,
+		future:  &UpdateFuture{done: make(chan struct{}), callback: callback, unobserved: unobserved},
+	}
+	queue.closeLock.RLock()
+	if queue.closed {
+		queue.closeLock.RUnlock()
+		return nil, ErrQueueClosed
+	}
+	if queue.options.Policy == BackpressureBlock {
+		queue.jobs <- job
+		queue.closeLock.RUnlock()
+		return job.future, nil
+	}
+	defer queue.closeLock.RUnlock()
+	select {
+	case queue.jobs <- job:
+	default:
+		if queue.options.Policy == BackpressureError {
+			return nil, ErrQueueFull
+		}
+		// the callback must not run on the goroutine of the caller, which may hold locks the callback needs
+		job.future.resolve(nil, ErrUpdateDropped)
+		queue.failed(job.future)
+		if callback != nil {
+			queue.droppedLock.Lock()
+			queue.dropped = append(queue.dropped, job.future)
+			queue.droppedLock.Unlock()
+		}
+	}
+	return job.future, nil
+}
+
+func (queue *UpdateQueue) work() {
+	defer queue.workers.Done()
+	for job := range queue.jobs {
+		queue.callDropped()
+		job.future.complete(queue.tx.update(context.Background(), job.updates...))
+		queue.failed(job.future)
+	}
+	// drops happen before the queue is closed, so none are left after the last worker ran this
+	queue.callDropped()
+}
+
+// Keeps the error of a completed future that is not handed out to the caller, unless an earlier failure is kept.
+func (queue *UpdateQueue) failed(f *UpdateFuture) {
+	if !f.unobserved || f.err == nil {
+		return
+	}
+	queue.failureLock.Lock()
+	defer queue.failureLock.Unlock()
+	if queue.failure == nil {
+		queue.failure = f.err
+	}
+}
+
+// Invokes the callbacks of dropped updates.
+// The buffer is full when updates are dropped, so a worker calls this before long.
+func (queue *UpdateQueue) callDropped() {
+	queue.droppedLock.Lock()
+	dropped := queue.dropped
+	queue.dropped = nil
+	queue.droppedLock.Unlock()
+	for _, f := range dropped {
+		f.call()
+	}
+}
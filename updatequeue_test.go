@@ -0,0 +1,156 @@
+package antidoteclient
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUpdateQueueFutures(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queue := client.NewUpdateQueue(UpdateQueueOptions{Workers: 4})
+	bucket := []byte("bucket")
+	future, err := queue.Submit(CounterInc(Key("a"), 1).ConvertToToplevel(bucket))
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitTime, err := future.Wait()
+	if err != nil || !bytes.Equal(commitTime, []byte("ct")) {
+		t.Fatalf("unexpected result: %s, %v", commitTime, err)
+	}
+
+	results := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		err = queue.SubmitCallback(func(commitTime []byte, err error) { results <- err }, CounterInc(Key("a"), 1).ConvertToToplevel(bucket))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// closing the client flushes the queue
+	client.Close()
+	if len(results) != 10 {
+		t.Fatalf("expected 10 completed callbacks after close, got %d", len(results))
+	}
+	if n := len(server.received(MsgStaticUpdateObjects)); n != 11 {
+		t.Fatalf("expected 11 static updates, got %d", n)
+	}
+	if _, err = queue.Submit(CounterInc(Key("a"), 1).ConvertToToplevel(bucket)); err != ErrQueueClosed {
+		t.Fatalf("expected ErrQueueClosed, got %v", err)
+	}
+}
+
+func TestUpdateQueueBackpressure(t *testing.T) {
+	release := make(chan struct{})
+	server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		<-release
+		return ackHandler(code, data)
+	})
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	update := CounterInc(Key("a"), 1).ConvertToToplevel([]byte("bucket"))
+
+	for _, policy := range []BackpressurePolicy{BackpressureError, BackpressureDrop} {
+		queue := client.NewUpdateQueue(UpdateQueueOptions{QueueSize: 1, Policy: policy})
+		sent := len(server.received(MsgStaticUpdateObjects))
+		first, _ := queue.Submit(update)
+		// wait until the worker is busy with the first update
+		for len(server.received(MsgStaticUpdateObjects)) == sent {
+			time.Sleep(time.Millisecond)
+		}
+		second, err := queue.Submit(update)
+		if err != nil {
+			t.Fatal(err)
+		}
+		third, err := queue.Submit(update)
+		switch policy {
+		case BackpressureError:
+			if err != ErrQueueFull {
+				t.Fatalf("expected ErrQueueFull, got %v", err)
+			}
+		case BackpressureDrop:
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = third.Wait(); err != ErrUpdateDropped {
+				t.Fatalf("expected ErrUpdateDropped, got %v", err)
+			}
+			// the callback of a dropped update runs on a worker, not while the caller holds its locks
+			var mutex sync.Mutex
+			dropped := make(chan error, 1)
+			mutex.Lock()
+			err = queue.SubmitCallback(func(commitTime []byte, err error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				dropped <- err
+			}, update)
+			mutex.Unlock()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := <-dropped; err != ErrUpdateDropped {
+					t.Fatalf("expected ErrUpdateDropped, got %v", err)
+				}
+			}()
+		}
+		release <- struct{}{}
+		release <- struct{}{}
+		if _, err = first.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = second.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		queue.Close()
+	}
+}
+
+func TestUpdateQueueCloseReportsFailures(t *testing.T) {
+	server := newFakeServer(t, func(code MessageCode, data []byte) (MessageCode, proto.Message) {
+		if code == MsgStaticUpdateObjects {
+			return MsgCommitResp, &ApbCommitResp{Success: proto.Bool(false), Errorcode: proto.Uint32(3)}
+		}
+		return ackHandler(code, data)
+	})
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	update := CounterInc(Key("a"), 1).ConvertToToplevel([]byte("bucket"))
+
+	// failures observed through a future are not reported again
+	queue := client.NewUpdateQueue(UpdateQueueOptions{})
+	future, err := queue.Submit(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = future.Wait(); err == nil {
+		t.Fatal("expected the update to fail")
+	}
+	if err = queue.Close(); err != nil {
+		t.Fatalf("expected no unobserved failure, got %v", err)
+	}
+
+	// updates submitted as a Transaction have no future, their failure is returned by Close
+	queue = client.NewUpdateQueue(UpdateQueueOptions{})
+	if err = (&Bucket{Bucket: []byte("bucket")}).Update(queue, CounterInc(Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	var serverErr *ServerError
+	if err = queue.Close(); !errors.As(err, &serverErr) || serverErr.Code != 3 {
+		t.Fatalf("expected the server error, got %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package antidoteclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Configures when a WriteBatch sends its buffered updates to Antidote.
+type WriteBatchOptions struct {
+	// Flush as soon as updates to this many distinct objects are buffered; 0 disables the size threshold
+	MaxObjects int
+	// Flush buffered updates periodically; 0 disables the time threshold
+	FlushInterval time.Duration
+	// Called with errors of flushes triggered by FlushInterval.
+	// If nil, the error is returned by the next call to Flush or Close.
+	OnError func(err error)
+}
+
+// Buffers updates on the client side and sends them to Antidote as a single static transaction.
+// Updates to the same object are coalesced: counter increments are summed up,
+// set additions and removals are combined, the last write to a register or flag wins
+// and updates of the same nested map are merged.
+//
+// A WriteBatch implements the Transaction interface and can be used with Bucket.Update.
+// Reads issued through the batch flush pending updates first and read a snapshot including the last flush,
+// so they observe the batch's own writes.
+// Updating a nested map entry removed by a buffered update flushes the buffered updates first, to keep the order of the operations.
+// Updates of a flush that fails are discarded.
+// A WriteBatch is safe for concurrent use. Always close it to send the remaining updates.
+type WriteBatch struct {
+	tx      *StaticTransaction
+	options WriteBatchOptions
+
+	mutex   sync.Mutex
+	buckets map[string]*mapUpdateNode
+	order   []string
+	objects int
+	err     error
+	// commit time of the last flush
+	clock []byte
+
+	client    *Client
+	closeOnce sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// Creates a batch that flushes buffered updates with static transactions of this client.
+func (client *Client) NewWriteBatch(options WriteBatchOptions) *WriteBatch {
+	batch := &WriteBatch{
+		tx:      client.CreateStaticTransaction(),
+		options: options,
+		buckets: make(map[string]*mapUpdateNode),
+		client:  client,
+	}
+	if options.FlushInterval > 0 {
+		batch.stop = make(chan struct{})
+		batch.done = make(chan struct{})
+		go batch.flushPeriodically()
+	}
+	client.addBatch(batch)
+	return batch
+}
+
+// Buffers the updates. Flushes the batch if the size threshold is reached.
+// If one of the updates is invalid, none of them is buffered.
+func (batch *WriteBatch) Update(updates ...*ApbUpdateOp) error {
+	for _, u := range updates {
+		if err := validateUpdate(u); err != nil {
+			return err
+		}
+	}
+	batch.mutex.Lock()
+	defer batch.mutex.Unlock()
+	for _, u := range updates {
+		bucket := string(u.Boundobject.Bucket)
+		id := mapEntryID{string(u.Boundobject.Key), u.Boundobject.GetType()}
+		node, ok := batch.buckets[bucket]
+		if ok && node.conflicts(id, u.Operation) {
+			if err := batch.flush(); err != nil {
+				return err
+			}
+			ok = false
+		}
+		if !ok {
+			node = newMapUpdateNode()
+			batch.buckets[bucket] = node
+			batch.order = append(batch.order, bucket)
+		}
+		before := len(node.order)
+		if err := node.merge(id.key, id.crdtType, u.Operation); err != nil {
+			return err
+		}
+		batch.objects += len(node.order) - before
+	}
+	if batch.options.MaxObjects > 0 && batch.objects >= batch.options.MaxObjects {
+		return batch.flush()
+	}
+	return nil
+}
+
+// Flushes pending updates and reads the objects with a static transaction
+// in a snapshot including the updates flushed by the batch.
+func (batch *WriteBatch) Read(objects ...*ApbBoundObject) (resp *ApbReadObjectsResp, err error) {
+	err = batch.Flush()
+	if err != nil {
+		return
+	}
+	batch.mutex.Lock()
+	clock := batch.clock
+	batch.mutex.Unlock()
+	return batch.tx.read(context.Background(), clock, objects...)
+}
+
+// Sends all buffered updates to Antidote as a single static transaction.
+func (batch *WriteBatch) Flush() error {
+	batch.mutex.Lock()
+	defer batch.mutex.Unlock()
+	err := batch.flush()
+	if err == nil {
+		err = batch.err
+	}
+	batch.err = nil
+	return err
+}
+
+// Stops periodic flushing and sends the remaining updates.
+func (batch *WriteBatch) Close() error {
+	batch.closeOnce.Do(func() {
+		if batch.stop != nil {
+			close(batch.stop)
+			<-batch.done
+		}
+		batch.client.removeBatch(batch)
+	})
+	return batch.Flush()
+}
+
+// Returns the buffered updates as top-level update operations.
+func (batch *WriteBatch) pending() []*ApbUpdateOp {
+	var ops []*ApbUpdateOp
+	for _, bucket := range batch.order {
+		batch.buckets[bucket].each(func(id mapEntryID, op *ApbUpdateOperation) {
+			crdtType := id.crdtType
+			ops = append(ops, &ApbUpdateOp{
+				Boundobject: &ApbBoundObject{Bucket: []byte(bucket), Key: Key(id.key), Type: &crdtType},
+				Operation:   op,
+			})
+		})
+	}
+	return ops
+}
+
+// Sends the buffered updates; the caller has to hold the mutex.
+func (batch *WriteBatch) flush() error {
+	if batch.objects == 0 {
+		return nil
+	}
+	ops := batch.pending()
+	batch.buckets = make(map[string]*mapUpdateNode)
+	batch.order = nil
+	batch.objects = 0
+	clock, err := batch.tx.update(context.Background(), ops...)
+	if err == nil {
+		batch.clock = clock
+	}
+	return err
+}
+
+// Checks that the update names an object and its type, and that it can be merged on its own.
+func validateUpdate(u *ApbUpdateOp) error {
+	if u.GetBoundobject() == nil || u.Boundobject.Type == nil || u.Operation == nil {
+		return fmt.Errorf("invalid update: object, type and operation are required")
+	}
+	if err := validateMapUpdate(u.Operation); err != nil {
+		return fmt.Errorf("invalid update of %s '%s': %w", u.Boundobject.GetType(), u.Boundobject.Key, err)
+	}
+	return newMapUpdateNode().merge(string(u.Boundobject.Key), u.Boundobject.GetType(), u.Operation)
+}
+
+// Checks that the nested updates and removals of a map update name their key type
+func validateMapUpdate(op *ApbUpdateOperation) error {
+	if op.Mapop == nil {
+		return nil
+	}
+	for _, nu := range op.Mapop.Updates {
+		if nu.GetKey() == nil || nu.Key.Type == nil || nu.Update == nil {
+			return fmt.Errorf("nested update without key, type or operation")
+		}
+		if err := validateMapUpdate(nu.Update); err != nil {
+			return err
+		}
+	}
+	for _, rk := range op.Mapop.RemovedKeys {
+		if rk.Type == nil {
+			return fmt.Errorf("removed key '%s' without type", rk.Key)
+		}
+	}
+	return nil
+}
+
+func (batch *WriteBatch) flushPeriodically() {
+	defer close(batch.done)
+	ticker := time.NewTicker(batch.options.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-batch.stop:
+			return
+		case <-ticker.C:
+			batch.mutex.Lock()
+			err := batch.flush()
+			if err != nil && batch.options.OnError == nil {
+				batch.err = err
+			}
+			batch.mutex.Unlock()
+			if err != nil && batch.options.OnError != nil {
+				batch.options.OnError(err)
+			}
+		}
+	}
+}
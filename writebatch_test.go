@@ -0,0 +1,179 @@
+package antidoteclient
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func staticUpdates(t *testing.T, s *fakeServer) []*ApbStaticUpdateObjects {
+	var res []*ApbStaticUpdateObjects
+	for _, r := range s.received(MsgStaticUpdateObjects) {
+		msg := &ApbStaticUpdateObjects{}
+		if err := proto.Unmarshal(r.data, msg); err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, msg)
+	}
+	return res
+}
+
+func TestWriteBatchCoalesce(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	batch := client.NewWriteBatch(WriteBatchOptions{})
+	b1 := Bucket{[]byte("b1")}
+	b2 := Bucket{[]byte("b2")}
+	for i := 0; i < 10; i++ {
+		if err := b1.Update(batch, CounterInc(Key("hits"), 1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b1.Update(batch, SetAdd(Key("set"), []byte("a")), SetAdd(Key("set"), []byte("b")))
+	b2.Update(batch, RegPut(Key("reg"), []byte("old")), RegPut(Key("reg"), []byte("new")))
+	b2.Update(batch, MapUpdate(Key("map"), CounterInc(Key("c"), 1)), MapUpdate(Key("map"), CounterInc(Key("c"), 2)))
+
+	if n := len(staticUpdates(t, server)); n != 0 {
+		t.Fatalf("updates sent before flush: %d", n)
+	}
+	if err := batch.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := staticUpdates(t, server)
+	if len(sent) != 1 {
+		t.Fatalf("expected exactly one static update, got %d", len(sent))
+	}
+	expected := []*ApbUpdateOp{
+		CounterInc(Key("hits"), 10).ConvertToToplevel(b1.Bucket),
+		SetAdd(Key("set"), []byte("a"), []byte("b")).ConvertToToplevel(b1.Bucket),
+		RegPut(Key("reg"), []byte("new")).ConvertToToplevel(b2.Bucket),
+		MapUpdate(Key("map"), CounterInc(Key("c"), 3)).ConvertToToplevel(b2.Bucket),
+	}
+	if len(sent[0].Updates) != len(expected) {
+		t.Fatalf("unexpected updates: %v", sent[0].Updates)
+	}
+	for i, u := range sent[0].Updates {
+		if !proto.Equal(u, expected[i]) {
+			t.Fatalf("unexpected update %d:\n%v\nexpected:\n%v", i, u, expected[i])
+		}
+	}
+}
+
+func TestWriteBatchThresholds(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	bucket := Bucket{[]byte("bucket")}
+	batch := client.NewWriteBatch(WriteBatchOptions{MaxObjects: 2})
+	bucket.Update(batch, CounterInc(Key("a"), 1), CounterInc(Key("a"), 1))
+	if n := len(staticUpdates(t, server)); n != 0 {
+		t.Fatalf("flushed before reaching the size threshold: %d", n)
+	}
+	bucket.Update(batch, CounterInc(Key("b"), 1))
+	if n := len(staticUpdates(t, server)); n != 1 {
+		t.Fatalf("expected flush when reaching the size threshold, got %d", n)
+	}
+	batch.Close()
+
+	batch = client.NewWriteBatch(WriteBatchOptions{FlushInterval: 10 * time.Millisecond})
+	defer batch.Close()
+	bucket.Update(batch, CounterInc(Key("a"), 1))
+	deadline := time.Now().Add(time.Second)
+	for len(staticUpdates(t, server)) != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("batch not flushed periodically")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestWriteBatchReadYourWrites(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	bucket := Bucket{[]byte("bucket")}
+	batch := client.NewWriteBatch(WriteBatchOptions{})
+	defer batch.Close()
+	bucket.Update(batch, CounterInc(Key("a"), 1))
+	if _, err = batch.Read(&ApbBoundObject{Bucket: bucket.Bucket, Key: Key("a"), Type: CRDTType_COUNTER.Enum()}); err != nil {
+		t.Fatal(err)
+	}
+	reads := server.received(MsgStaticReadObjects)
+	if len(reads) != 1 || len(staticUpdates(t, server)) != 1 {
+		t.Fatal("expected a flush followed by a read")
+	}
+	read := &ApbStaticReadObjects{}
+	if err = proto.Unmarshal(reads[0].data, read); err != nil {
+		t.Fatal(err)
+	}
+	if string(read.Transaction.GetTimestamp()) != "ct" {
+		t.Fatalf("expected read at the commit time of the flush, got %q", read.Transaction.GetTimestamp())
+	}
+}
+
+func TestWriteBatchUpdateOrder(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	bucket := Bucket{[]byte("bucket")}
+	batch := client.NewWriteBatch(WriteBatchOptions{})
+	removal := MapRemove(Key("map"), MapEntryKey{[]byte("reg"), CRDTType_LWWREG})
+	update := MapUpdate(Key("map"), RegPut(Key("reg"), []byte("new")))
+	if err = bucket.Update(batch, CounterInc(Key("c"), 1), removal, update); err != nil {
+		t.Fatal(err)
+	}
+	if err = batch.Close(); err != nil {
+		t.Fatal(err)
+	}
+	sent := staticUpdates(t, server)
+	if len(sent) != 2 || len(sent[0].Updates) != 2 || len(sent[1].Updates) != 1 || !proto.Equal(sent[1].Updates[0], update.ConvertToToplevel(bucket.Bucket)) {
+		t.Fatalf("expected the removal to be sent before the update, got %v", sent)
+	}
+
+	// invalid updates are rejected as a whole
+	batch = client.NewWriteBatch(WriteBatchOptions{})
+	defer batch.Close()
+	invalid := &ApbUpdateOp{Boundobject: &ApbBoundObject{Bucket: bucket.Bucket, Key: Key("x")}, Operation: update.Update}
+	if err = batch.Update(CounterInc(Key("c"), 1).ConvertToToplevel(bucket.Bucket), invalid); err == nil {
+		t.Fatal("expected error for update without type")
+	}
+	if err = batch.Flush(); err != nil || len(staticUpdates(t, server)) != 2 {
+		t.Fatalf("expected no updates to be buffered (%v)", err)
+	}
+}
+
+func TestWriteBatchClientClose(t *testing.T) {
+	server := newFakeServer(t, ackHandler)
+	client, err := NewClient(server.host())
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := client.NewWriteBatch(WriteBatchOptions{})
+	if err = (&Bucket{[]byte("bucket")}).Update(batch, CounterInc(Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	// closing the client sends the buffered updates
+	client.Close()
+	if n := len(staticUpdates(t, server)); n != 1 {
+		t.Fatalf("expected the batch to be flushed on close, got %d updates", n)
+	}
+}